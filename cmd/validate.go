@@ -4,6 +4,7 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strings"
@@ -55,12 +56,6 @@ func newValidateCmd() *cobra.Command {
 				handleCommandError(err, "Failed to create validator", 1)
 			}
 
-			// Get commits to validate
-			commits, err := validator.GetCommitsToValidate()
-			if err != nil {
-				handleCommandError(err, "Failed to get commits", 1)
-			}
-
 			// Create printer options with proper verbose/help settings
 			printOpts := &internal.PrintOptions{
 				Verbose:        opts.Verbose,
@@ -69,6 +64,42 @@ func newValidateCmd() *cobra.Command {
 				LightMode:      opts.LightMode,
 			}
 
+			// Validate tags instead of commits
+			if opts.TagPattern != "" {
+				tagCount, passedTags, err := validateTags(cmd, validator, opts, printOpts)
+				if err != nil {
+					handleCommandError(err, "Failed to get tags", 1)
+				}
+
+				if tagCount != passedTags {
+					fmt.Fprintln(os.Stderr, color.New(color.FgRed, color.Bold).Sprint("Validation failed: some tags did not pass all rules"))
+					os.Exit(2)
+				}
+
+				return
+			}
+
+			// Validate the branch name instead of commits
+			if opts.ValidateBranch {
+				passed, err := validateBranch(validator, opts, printOpts)
+				if err != nil {
+					handleCommandError(err, "Failed to validate branch", 1)
+				}
+
+				if !passed {
+					fmt.Fprintln(os.Stderr, color.New(color.FgRed, color.Bold).Sprint("Validation failed: the branch name did not pass all rules"))
+					os.Exit(2)
+				}
+
+				return
+			}
+
+			// Get commits to validate
+			commits, err := validator.GetCommitsToValidate()
+			if err != nil {
+				handleCommandError(err, "Failed to get commits", 1)
+			}
+
 			passedCommits := 0
 
 			// For each commit, validate and print results
@@ -88,7 +119,7 @@ func newValidateCmd() *cobra.Command {
 				// Track if this commit passed (all rules passed)
 				commitPassed := true
 				for _, rule := range rules.All() {
-					if len(rule.Errors()) > 0 {
+					if model.Failed(rule) {
 						commitPassed = false
 
 						break
@@ -105,6 +136,7 @@ func newValidateCmd() *cobra.Command {
 				printOverallSummary(
 					len(commits),
 					passedCommits,
+					"commits",
 					color.NoColor,  // Use the current global color setting
 					opts.LightMode, // Use light mode setting from options
 				)
@@ -122,17 +154,92 @@ func newValidateCmd() *cobra.Command {
 	validateCmd.Flags().String("message-file", "", "commit message file path to validate")
 	validateCmd.Flags().String("git-reference", "", "git reference to validate (defaults to auto-detected main branch)")
 	validateCmd.Flags().String("revision-range", "", "range of commits to validate (<commit1>..<commit2>)")
+	validateCmd.Flags().String("target-branch", "", "branch the commits are merged into, used by 'when: branches' conditions (defaults to base-branch, the CI target branch or the current branch)")
 	validateCmd.Flags().String("base-branch", "", "base branch to compare with (sets revision-range to <base-branch>..HEAD and overrides git-reference)")
 	validateCmd.Flags().BoolP("verbose", "v", false, "show detailed validation results")
 	validateCmd.Flags().Bool("extra-verbose", false, "show extra detailed validation results")
 	validateCmd.Flags().Bool("light-mode", false, "use light background color scheme")
 	validateCmd.Flags().String("rulehelp", "", "show detailed help for a specific rule (e.g., --rulehelp=signature)")
+	validateCmd.Flags().String("tags", "", "validate tags matching a glob pattern instead of commits (e.g., --tags='v*', all tags if no pattern is given)")
+	validateCmd.Flags().Lookup("tags").NoOptDefVal = "*"
+	validateCmd.Flags().String("branch", "", "validate a branch name instead of commits (the CI or current branch if no name is given)")
+	validateCmd.Flags().Lookup("branch").NoOptDefVal = "HEAD"
 
 	return validateCmd
 }
 
-// Print overall summary focused on commit success/failure.
-func printOverallSummary(totalCommits int, passedCommits int, noColor bool, lightMode bool) {
+// validateTags validates and prints every tag matching the tag pattern option.
+// It returns the number of validated tags and how many of them passed all rules.
+func validateTags(cmd *cobra.Command, validator *validation.Validator, opts *model.Options, printOpts *internal.PrintOptions) (int, int, error) {
+	tags, err := validator.GetTagsToValidate()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if len(tags) == 0 {
+		cmd.Printf("No tags matching '%s' found\n", opts.TagPattern)
+
+		return 0, 0, nil
+	}
+
+	passedTags := 0
+
+	for _, tagInfo := range tags {
+		rules, err := validator.ValidateTag(tagInfo)
+		if err != nil {
+			continue
+		}
+
+		err = internal.PrintTagReport(rules.All(), &tagInfo, printOpts)
+		if err != nil {
+			continue
+		}
+
+		tagPassed := true
+		for _, rule := range rules.All() {
+			if model.Failed(rule) {
+				tagPassed = false
+
+				break
+			}
+		}
+
+		if tagPassed {
+			passedTags++
+		}
+	}
+
+	if len(tags) > 1 {
+		printOverallSummary(len(tags), passedTags, "tags", color.NoColor, opts.LightMode)
+	}
+
+	return len(tags), passedTags, nil
+}
+
+// validateBranch validates and prints the branch name option.
+// It returns whether the branch name passed all rules.
+func validateBranch(validator *validation.Validator, opts *model.Options, printOpts *internal.PrintOptions) (bool, error) {
+	rules, err := validator.ValidateBranch()
+	if err != nil {
+		return false, err
+	}
+
+	err = internal.PrintBranchReport(rules.All(), opts.Branch, printOpts)
+	if err != nil {
+		return false, err
+	}
+
+	for _, rule := range rules.All() {
+		if model.Failed(rule) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// Print overall summary focused on commit (or tag) success/failure.
+func printOverallSummary(totalCommits int, passedCommits int, noun string, noColor bool, lightMode bool) {
 	// Create a divider line
 	divider := strings.Repeat("=", 80)
 
@@ -157,9 +264,9 @@ func printOverallSummary(totalCommits int, passedCommits int, noColor bool, ligh
 	fmt.Println(summaryColor(divider))
 	fmt.Println(summaryColor("OVERALL SUMMARY"))
 	fmt.Println(summaryColor(divider))
-	fmt.Printf("%s Validated %d commits\n", summaryColor("Result:"), totalCommits)
-	fmt.Printf("  %s %d commits passed\n", summaryColor("Passed:"), passedCommits)
-	fmt.Printf("  %s %d commits failed\n", summaryColor("Failed:"), failedCommits)
+	fmt.Printf("%s Validated %d %s\n", summaryColor("Result:"), totalCommits, noun)
+	fmt.Printf("  %s %d %s passed\n", summaryColor("Passed:"), passedCommits, noun)
+	fmt.Printf("  %s %d %s failed\n", summaryColor("Failed:"), failedCommits, noun)
 	fmt.Println()
 }
 
@@ -194,6 +301,39 @@ func processFlags(cmd *cobra.Command, git gitService.Service) (*model.Options, e
 		cmd.Printf("Auto-detected main branch: %s\n", mainBranch)
 	}
 
+	// Target branch for when: conditions: the flag, the base branch, the branch a CI pull
+	// request is merged into, the branch CI builds and finally the checked out branch
+	targetBranch, err := cmd.Flags().GetString("target-branch")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get target-branch flag: %w", err)
+	}
+
+	baseBranch, err := cmd.Flags().GetString("base-branch")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get base-branch flag: %w", err)
+	}
+
+	switch {
+	case targetBranch != "":
+		opts.TargetBranch = targetBranch
+	case baseBranch != "":
+		opts.TargetBranch = baseBranch
+	default:
+		opts.TargetBranch = gitService.CITargetBranch(os.Getenv)
+		if opts.TargetBranch == "" {
+			opts.TargetBranch = gitService.CIBranch(os.Getenv)
+		}
+
+		if opts.TargetBranch == "" {
+			opts.TargetBranch, _ = git.CurrentBranch()
+		}
+
+		// A detached HEAD outside CI names no branch
+		if opts.TargetBranch == "" {
+			cmd.PrintErrln("Warning: the target branch is unknown, so 'when: branches' conditions match every commit; set --target-branch")
+		}
+	}
+
 	// 1. First check for commit message file
 	msgFromFile, err := cmd.Flags().GetString("message-file")
 	if err != nil {
@@ -205,6 +345,44 @@ func processFlags(cmd *cobra.Command, git gitService.Service) (*model.Options, e
 		opts.LightMode = lightMode
 	}
 
+	tagPattern, err := cmd.Flags().GetString("tags")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags flag: %w", err)
+	}
+
+	branch, err := cmd.Flags().GetString("branch")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get branch flag: %w", err)
+	}
+
+	if branch != "" {
+		if tagPattern != "" {
+			return nil, errors.New("--branch cannot be combined with --tags")
+		}
+
+		// Without a name, CI systems name the branch, since they often check out a detached HEAD
+		if branch == "HEAD" {
+			branch = gitService.CIBranch(os.Getenv)
+		}
+
+		if branch == "" {
+			branch, _ = git.CurrentBranch()
+		}
+
+		opts.ValidateBranch = true
+		opts.Branch = branch
+	}
+
+	if tagPattern != "" {
+		if msgFromFile != "" {
+			return nil, errors.New("--tags cannot be combined with --message-file")
+		}
+
+		opts.TagPattern = tagPattern
+
+		return opts, nil
+	}
+
 	if msgFromFile != "" {
 		opts.MsgFromFile = &msgFromFile
 
@@ -212,14 +390,11 @@ func processFlags(cmd *cobra.Command, git gitService.Service) (*model.Options, e
 	}
 
 	// 2. Check for base branch
-	baseBranch, err := cmd.Flags().GetString("base-branch")
-	if err != nil {
-		return nil, fmt.Errorf("failed to get base-branch flag: %w", err)
-	}
-
 	if baseBranch != "" {
 		opts.RevisionRange = baseBranch + "..HEAD"
 		opts.CommitRef = "refs/heads/" + baseBranch
+		opts.BaseBranch = baseBranch
+
 		cmd.Printf("Using base-branch: %s (overrides git-reference if provided)\n", baseBranch)
 
 		return opts, nil
@@ -11,6 +11,7 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/fatih/color"
 	"github.com/go-git/go-git/v5"
@@ -19,6 +20,7 @@ import (
 	"github.com/itiquette/gommitlint/internal"
 	"github.com/itiquette/gommitlint/internal/configuration"
 	gitService "github.com/itiquette/gommitlint/internal/git"
+	"github.com/itiquette/gommitlint/internal/model"
 	"github.com/itiquette/gommitlint/internal/validation"
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/require"
@@ -406,6 +408,271 @@ Signed-off-by: Test User <test@example.com>`
 	}
 }
 
+func TestValidateTagsCmd(t *testing.T) {
+	configContent := `
+gommitlint:
+  signature:
+    required: false
+`
+
+	tagger := &object.Signature{Name: "Test User", Email: "test@example.com", When: time.Now()}
+
+	tests := []struct {
+		name           string
+		setup          func(t *testing.T, repo *git.Repository, head plumbing.Hash)
+		args           []string
+		expectedOutput string
+		expectedError  bool
+	}{
+		{
+			name: "annotated_semver_tag",
+			setup: func(t *testing.T, repo *git.Repository, head plumbing.Hash) {
+				t.Helper()
+
+				_, err := repo.CreateTag("v1.0.0", head, &git.CreateTagOptions{Tagger: tagger, Message: "Release v1.0.0"})
+				require.NoError(t, err)
+			},
+			args:           []string{"--tags"},
+			expectedOutput: "✓ TagName: Valid tag name",
+		},
+		{
+			name: "lightweight_tag",
+			setup: func(t *testing.T, repo *git.Repository, head plumbing.Hash) {
+				t.Helper()
+
+				_, err := repo.CreateTag("v1.0.0", head, nil)
+				require.NoError(t, err)
+			},
+			args:           []string{"--tags"},
+			expectedOutput: "✗ AnnotatedTag: Lightweight tag",
+			expectedError:  true,
+		},
+		{
+			name: "pattern_selects_tags",
+			setup: func(t *testing.T, repo *git.Repository, head plumbing.Hash) {
+				t.Helper()
+
+				_, err := repo.CreateTag("v1.0.0", head, &git.CreateTagOptions{Tagger: tagger, Message: "Release v1.0.0"})
+				require.NoError(t, err)
+				_, err = repo.CreateTag("v1.1.0", head, &git.CreateTagOptions{Tagger: tagger, Message: "Release v1.1.0"})
+				require.NoError(t, err)
+				_, err = repo.CreateTag("nightly", head, nil)
+				require.NoError(t, err)
+			},
+			args:           []string{"--tags=v1.*"},
+			expectedOutput: "Validated 2 tags",
+		},
+		{
+			name:           "no_matching_tags",
+			setup:          func(_ *testing.T, _ *git.Repository, _ plumbing.Hash) {},
+			args:           []string{"--tags=v*"},
+			expectedOutput: "No tags matching 'v*' found",
+		},
+		{
+			name:          "tags_with_message_file",
+			setup:         func(_ *testing.T, _ *git.Repository, _ plumbing.Hash) {},
+			args:          []string{"--tags", "--message-file", "COMMIT_MSG"},
+			expectedError: true,
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			repoPath := filepath.Join(t.TempDir(), tabletest.name)
+			repo := setupTestRepo(t, repoPath)
+
+			head, err := repo.Head()
+			require.NoError(t, err)
+
+			tabletest.setup(t, repo, head.Hash())
+
+			currentDir, err := os.Getwd()
+			require.NoError(t, err)
+
+			err = os.Chdir(repoPath)
+			require.NoError(t, err)
+			defer os.Chdir(currentDir) //nolint
+
+			err = os.WriteFile(".gommitlint.yaml", []byte(configContent), 0600)
+			require.NoError(t, err)
+
+			output, err := executeCommandForTest(t, createTestCommand(), tabletest.args...)
+
+			if tabletest.expectedError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err, "Output: %s", output)
+			}
+
+			require.Contains(t, output, tabletest.expectedOutput, "Output: %s", output)
+		})
+	}
+}
+
+func TestValidateBranchCmd(t *testing.T) {
+	configContent := `
+gommitlint:
+  signature:
+    required: false
+  branch:
+    patterns: ['^(feat|fix)/[A-Z]+-\d+-[a-z0-9-]+$']
+    match-commit-keys: true
+`
+
+	tests := []struct {
+		name           string
+		env            map[string]string
+		args           []string
+		expectedOutput string
+		expectedError  bool
+	}{
+		{
+			name:           "valid_branch",
+			args:           []string{"--branch=feat/PROJ-1-initial"},
+			expectedOutput: "✓ BranchName: Valid branch name",
+		},
+		{
+			name:           "invalid_branch",
+			args:           []string{"--branch=my-branch"},
+			expectedOutput: "✗ BranchName: Invalid branch name",
+			expectedError:  true,
+		},
+		{
+			name:           "commit_key_mismatch",
+			args:           []string{"--branch=feat/PROJ-1-initial", "--message-file", "COMMIT_MSG"},
+			expectedOutput: "✗ BranchName: Invalid branch name",
+			expectedError:  true,
+		},
+		{
+			name:           "ci_branch",
+			env:            map[string]string{"GITHUB_HEAD_REF": "fix/PROJ-2-ci"},
+			args:           []string{"--branch"},
+			expectedOutput: "BRANCH: fix/PROJ-2-ci",
+		},
+		{
+			name:          "branch_with_tags",
+			args:          []string{"--branch", "--tags"},
+			expectedError: true,
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			for key, value := range tabletest.env {
+				t.Setenv(key, value)
+			}
+
+			repoPath := filepath.Join(t.TempDir(), tabletest.name)
+			setupTestRepo(t, repoPath)
+
+			currentDir, err := os.Getwd()
+			require.NoError(t, err)
+
+			err = os.Chdir(repoPath)
+			require.NoError(t, err)
+			defer os.Chdir(currentDir) //nolint
+
+			err = os.WriteFile(".gommitlint.yaml", []byte(configContent), 0600)
+			require.NoError(t, err)
+
+			err = os.WriteFile("COMMIT_MSG", []byte("feat: add login OPS-9\n"), 0600)
+			require.NoError(t, err)
+
+			output, err := executeCommandForTest(t, createTestCommand(), tabletest.args...)
+
+			if tabletest.expectedError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err, "Output: %s", output)
+			}
+
+			require.Contains(t, output, tabletest.expectedOutput, "Output: %s", output)
+		})
+	}
+}
+
+func TestValidateDetachedHeadCmd(t *testing.T) {
+	configContent := `
+gommitlint:
+  signature:
+    required: false
+  custom-rules:
+    - name: ReleaseNotes
+      target: body
+      must-match: ['(?m)^Release-Note:']
+      when:
+        branches: [release/*]
+`
+
+	tests := []struct {
+		name           string
+		env            map[string]string
+		expectedOutput string
+		expectedError  bool
+	}{
+		{
+			name:           "ci_target_branch_matches",
+			env:            map[string]string{"GITHUB_BASE_REF": "release/1.0", "GITHUB_HEAD_REF": "feat/PROJ-1-login"},
+			expectedOutput: "ReleaseNotes",
+			expectedError:  true,
+		},
+		{
+			name: "ci_target_branch_does_not_match",
+			env:  map[string]string{"GITHUB_BASE_REF": "main", "GITHUB_HEAD_REF": "release/1.0"},
+		},
+		{
+			name:           "unknown_target_branch",
+			expectedOutput: "Warning: the target branch is unknown",
+			expectedError:  true,
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			// The CI variables of the environment running the tests must not leak in
+			for _, key := range []string{
+				"GITHUB_BASE_REF", "CI_MERGE_REQUEST_TARGET_BRANCH_NAME", "CHANGE_TARGET",
+				"GITHUB_HEAD_REF", "CI_MERGE_REQUEST_SOURCE_BRANCH_NAME", "CHANGE_BRANCH", "GITHUB_REF",
+				"CI_COMMIT_BRANCH", "BITBUCKET_BRANCH", "BUILDKITE_BRANCH", "CIRCLE_BRANCH", "BRANCH_NAME",
+			} {
+				t.Setenv(key, tabletest.env[key])
+			}
+
+			repoPath := filepath.Join(t.TempDir(), tabletest.name)
+			repo := setupTestRepo(t, repoPath)
+
+			// Check out the commit rather than a branch, as CI systems do
+			head, err := repo.Head()
+			require.NoError(t, err)
+			require.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference(plumbing.HEAD, head.Hash())))
+
+			currentDir, err := os.Getwd()
+			require.NoError(t, err)
+
+			err = os.Chdir(repoPath)
+			require.NoError(t, err)
+			defer os.Chdir(currentDir) //nolint
+
+			err = os.WriteFile(".gommitlint.yaml", []byte(configContent), 0600)
+			require.NoError(t, err)
+
+			err = os.WriteFile("COMMIT_MSG", []byte("feat: add login\n\nAdds a login form.\n\nSigned-off-by: Test User <test@example.com>\n"), 0600)
+			require.NoError(t, err)
+
+			output, err := executeCommandForTest(t, createTestCommand(), "--message-file", "COMMIT_MSG")
+
+			if tabletest.expectedError {
+				require.Error(t, err, "Output: %s", output)
+			} else {
+				require.NoError(t, err, "Output: %s", output)
+				require.NotContains(t, output, "Warning: the target branch is unknown")
+			}
+
+			require.Contains(t, output, tabletest.expectedOutput, "Output: %s", output)
+		})
+	}
+}
+
 // createTestCommand creates a test-safe version of the validate command that doesn't use os.Exit.
 func createTestCommand() *cobra.Command {
 	return &cobra.Command{
@@ -439,12 +706,6 @@ func createTestCommand() *cobra.Command {
 				return fmt.Errorf("Failed to create validator: %w", err)
 			}
 
-			// Get commits to validate
-			commits, err := validator.GetCommitsToValidate()
-			if err != nil {
-				return fmt.Errorf("Failed to get commits: %w", err)
-			}
-
 			// Create printer options with proper verbose/help settings
 			printOpts := &internal.PrintOptions{
 				Verbose:        opts.Verbose,
@@ -453,6 +714,40 @@ func createTestCommand() *cobra.Command {
 				LightMode:      opts.LightMode,
 			}
 
+			// Validate tags instead of commits
+			if opts.TagPattern != "" {
+				tagCount, passedTags, err := validateTags(cmd, validator, opts, printOpts)
+				if err != nil {
+					return fmt.Errorf("Failed to get tags: %w", err)
+				}
+
+				if tagCount != passedTags {
+					return errors.New("Validation failed: some tags did not pass all rules")
+				}
+
+				return nil
+			}
+
+			// Validate the branch name instead of commits
+			if opts.ValidateBranch {
+				passed, err := validateBranch(validator, opts, printOpts)
+				if err != nil {
+					return fmt.Errorf("Failed to validate branch: %w", err)
+				}
+
+				if !passed {
+					return errors.New("Validation failed: the branch name did not pass all rules")
+				}
+
+				return nil
+			}
+
+			// Get commits to validate
+			commits, err := validator.GetCommitsToValidate()
+			if err != nil {
+				return fmt.Errorf("Failed to get commits: %w", err)
+			}
+
 			passedCommits := 0
 
 			// For each commit, validate and print results
@@ -472,7 +767,7 @@ func createTestCommand() *cobra.Command {
 				// Track if this commit passed (all rules passed)
 				commitPassed := true
 				for _, rule := range rules.All() {
-					if len(rule.Errors()) > 0 {
+					if model.Failed(rule) {
 						commitPassed = false
 
 						break
@@ -489,6 +784,7 @@ func createTestCommand() *cobra.Command {
 				printOverallSummary(
 					len(commits),
 					passedCommits,
+					"commits",
 					color.NoColor,  // Use the current global color setting
 					opts.LightMode, // Use light mode setting from options
 				)
@@ -4,6 +4,14 @@
 
 package configuration
 
+import (
+	"regexp"
+	"time"
+
+	"github.com/itiquette/gommitlint/internal/expression"
+	"github.com/itiquette/gommitlint/internal/model"
+)
+
 // AppConf is the root configuration structure for the application.
 type AppConf struct {
 	GommitConf *GommitLintConfig `koanf:"gommitlint"`
@@ -23,20 +31,43 @@ func New() (*AppConf, error) {
 // GommitLintConfig defines the complete configuration for commit linting rules.
 type GommitLintConfig struct {
 	// Content validation rules
-	Subject            *SubjectRule      `koanf:"subject"`
-	Body               *BodyRule         `koanf:"body"`
-	ConventionalCommit *ConventionalRule `koanf:"conventional-commit"`
-	SpellCheck         *SpellingRule     `koanf:"spellcheck"`
+	Subject            *SubjectRule        `koanf:"subject"`
+	Body               *BodyRule           `koanf:"body"`
+	ConventionalCommit *ConventionalRule   `koanf:"conventional-commit"`
+	SpellCheck         *SpellingRule       `koanf:"spellcheck"`
+	BannedTerms        *BannedTermsRule    `koanf:"banned-terms"`
+	Trailers           *TrailersRule       `koanf:"trailers"`
+	CoAuthors          *CoAuthorsRule      `koanf:"co-authors"`
+	Autosquash         *AutosquashRule     `koanf:"autosquash"`
+	Revert             *RevertRule         `koanf:"revert"`
+	IssueReference     *IssueReferenceRule `koanf:"issue-reference"`
 	// Security validation rules
-	Signature       *SignatureRule `koanf:"signature"`
-	SignOffRequired *bool          `koanf:"sign-off"`
-	// Misc validation rules
-	NCommitsAhead      *bool `koanf:"n-commits-ahead"`
-	IgnoreMergeCommits *bool `koanf:"ignore-merge-commit"`
+	Signature        *SignatureRule        `koanf:"signature"`
+	SignOffRequired  *bool                 `koanf:"sign-off"`
+	SignOffIdentity  *SignOffIdentityRule  `koanf:"sign-off-identity"`
+	CommitIdentity   *CommitIdentityRule   `koanf:"commit-identity"`
+	SensitiveContent *SensitiveContentRule `koanf:"sensitive-content"`
+	// Misc validation rules. n-commits-ahead and ignore-merge-commit take no when:
+	// condition, since the number of commits ahead belongs to the branch rather than to
+	// a commit, and ignore-merge-commit itself selects the commits the rules apply to.
+	NCommitsAhead      *bool            `koanf:"n-commits-ahead"`
+	CommitSize         *CommitSizeRule  `koanf:"commit-size"`
+	MergePolicy        *MergePolicyRule `koanf:"merge-policy"`
+	IgnoreMergeCommits *bool            `koanf:"ignore-merge-commit"`
+	// Tag validation rules
+	Tag *TagRule `koanf:"tag"`
+	// Branch validation rules
+	Branch *BranchRule `koanf:"branch"`
+	// Rules declared in configuration
+	CustomRules     []CustomRule     `koanf:"custom-rules"`
+	ExpressionRules []ExpressionRule `koanf:"expression-rules"`
 }
 
 // SubjectRule defines configuration for commit subject validation.
 type SubjectRule struct {
+	// When limits the rules to matching commits (default: all commits).
+	When *WhenRule `koanf:"when"`
+
 	// Case specifies the case that the first word of the description must have ("upper","lower","ignore").
 	Case string `koanf:"case"`
 
@@ -55,6 +86,9 @@ type SubjectRule struct {
 
 // ConventionalRule defines configuration for conventional commit format validation.
 type ConventionalRule struct {
+	// When limits the rules to matching commits (default: all commits).
+	When *WhenRule `koanf:"when"`
+
 	// MaxDescriptionLength specifies the maximum allowed length for the description.
 	MaxDescriptionLength int `koanf:"max-description-length"`
 
@@ -66,15 +100,86 @@ type ConventionalRule struct {
 
 	// Required indicates whether Conventional Commits are required.
 	Required bool `koanf:"required"`
+
+	// BreakingChange configures the consistency checks for breaking changes.
+	BreakingChange *BreakingChangeRule `koanf:"breaking-change"`
+
+	// ScopePaths maps scopes to the repository paths they cover.
+	ScopePaths *ScopePathsRule `koanf:"scope-paths"`
+
+	// TypeContent checks that types such as docs or ci match the changed files.
+	TypeContent *TypeContentRule `koanf:"type-content"`
+}
+
+// BreakingChangeRule defines how breaking changes must be declared in conventional commits.
+type BreakingChangeRule struct {
+	// RequireFooter requires a "BREAKING CHANGE:" footer on commits marked with "!".
+	RequireFooter bool `koanf:"require-footer"`
+
+	// RequireMarker requires the "!" marker on commits with a "BREAKING CHANGE:" footer.
+	RequireMarker bool `koanf:"require-marker"`
+
+	// Types lists the commit types allowed to introduce breaking changes (empty allows all).
+	Types []string `koanf:"types"`
+
+	// MinFooterLength is the minimum length of the "BREAKING CHANGE:" description (0 disables the check).
+	MinFooterLength int `koanf:"min-footer-length"`
+}
+
+// ScopePathsRule maps conventional commit scopes to repository paths, e.g. in a monorepo.
+type ScopePathsRule struct {
+	// Scopes maps each scope to the path patterns it covers, e.g. billing: ["services/billing/**"].
+	Scopes map[string][]string `koanf:"scopes"`
+
+	// Ignore lists path patterns any scope may change, e.g. "go.sum".
+	Ignore []string `koanf:"ignore"`
+}
+
+// TypeContentRule classifies changed files so that the conventional type can be checked against them.
+type TypeContentRule struct {
+	// Categories maps types such as docs, test, ci and build to path patterns, replacing the defaults per type.
+	Categories map[string][]string `koanf:"categories"`
+
+	// Exempt lists types that may change any files (default: chore, revert).
+	Exempt []string `koanf:"exempt"`
 }
 
 // SpellingRule defines configuration for spell checking.
 type SpellingRule struct {
+	// When limits the rules to matching commits (default: all commits).
+	When *WhenRule `koanf:"when"`
+
 	// Locale specifies the language/locale to use for spell checking.
 	Locale string `koanf:"locale"`
 }
 
+// BannedTermsRule defines configuration for banned words and inclusive language.
+type BannedTermsRule struct {
+	// When limits the rule to matching commits (default: all commits).
+	When *WhenRule `koanf:"when"`
+
+	// Terms lists the banned words and phrases with suggested alternatives.
+	Terms []BannedTermRule `koanf:"terms"`
+
+	// Inclusive adds the built-in non-inclusive terms, such as master and slave.
+	Inclusive bool `koanf:"inclusive"`
+
+	// AllowIn lists contexts in which banned terms are allowed: code, quotes and urls (default: [code]).
+	AllowIn []string `koanf:"allow-in"`
+}
+
+// BannedTermRule defines a banned word or phrase.
+type BannedTermRule struct {
+	// Term is matched as whole words and case-insensitively.
+	Term string `koanf:"term"`
+
+	// Suggestions lists alternatives to use instead.
+	Suggestions []string `koanf:"suggestions"`
+}
+
 // JiraRule defines configuration for Jira key validation.
+// If issue-reference has a jira tracker, that tracker checks the keys instead and
+// defaults its projects to Keys.
 type JiraRule struct {
 	// Keys specifies the allowed Jira project keys.
 	Keys []string `koanf:"keys"`
@@ -88,12 +193,406 @@ type JiraRule struct {
 
 // BodyRule defines configuration for commit body validation.
 type BodyRule struct {
+	// When limits the rules to matching commits (default: all commits).
+	When *WhenRule `koanf:"when"`
+
 	// Required enforces that the current commit has a body.
 	Required bool `koanf:"required"`
+
+	// MaxLineLength enables the BodyLineLength rule with this limit (0 disables it).
+	MaxLineLength int `koanf:"max-line-length"`
+
+	// LineLengthExemptions configures which lines the BodyLineLength rule skips.
+	LineLengthExemptions *LineLengthExemptionsRule `koanf:"line-length-exemptions"`
+}
+
+// LineLengthExemptionsRule defines which body lines are exempt from the line length limit.
+type LineLengthExemptionsRule struct {
+	// URLs exempts lines containing a URL (default: true).
+	URLs *bool `koanf:"urls"`
+
+	// CodeBlocks exempts indented and fenced code blocks (default: true).
+	CodeBlocks *bool `koanf:"code-blocks"`
+
+	// Quotes exempts quoted lines starting with ">" (default: true).
+	Quotes *bool `koanf:"quotes"`
+
+	// Trailers exempts trailer lines such as "Signed-off-by:" (default: true).
+	Trailers *bool `koanf:"trailers"`
+
+	// Patterns lists regular expressions of additional exempt lines.
+	Patterns []string `koanf:"patterns"`
+}
+
+// TrailersRule defines configuration for trailer validation.
+type TrailersRule struct {
+	// When limits the rules to matching commits (default: all commits).
+	When *WhenRule `koanf:"when"`
+
+	// Keys lists the policies for individual trailer keys.
+	Keys []TrailerKeyRule `koanf:"keys"`
+
+	// Order lists trailer keys in the order they must appear, e.g. ["Refs", "Signed-off-by"].
+	Order []string `koanf:"order"`
+
+	// FinalParagraph rejects configured trailers outside the final paragraph (default: true).
+	FinalParagraph *bool `koanf:"final-paragraph"`
+}
+
+// TrailerKeyRule defines the policy for one trailer key.
+type TrailerKeyRule struct {
+	// Key is the trailer key, compared case-insensitively, e.g. "Reviewed-by".
+	Key string `koanf:"key"`
+
+	// Required enforces that the trailer appears at least once.
+	Required bool `koanf:"required"`
+
+	// Forbidden enforces that the trailer does not appear.
+	Forbidden bool `koanf:"forbidden"`
+
+	// Pattern is a regular expression every value of the trailer must match.
+	Pattern string `koanf:"pattern"`
+
+	// Min is the minimum number of occurrences.
+	Min int `koanf:"min"`
+
+	// Max is the maximum number of occurrences (0 means unlimited).
+	Max int `koanf:"max"`
+}
+
+// CoAuthorsRule defines configuration for Co-authored-by trailer validation.
+type CoAuthorsRule struct {
+	// When limits the rule to matching commits (default: all commits).
+	When *WhenRule `koanf:"when"`
+
+	// AllowedDomains lists email domains co-authors must use, including subdomains, e.g. "example.com".
+	AllowedDomains []string `koanf:"allowed-domains"`
+
+	// Mailmap is the path of a .mailmap file; co-authors listed in it are allowed.
+	// A relative path is resolved against the root of the repository.
+	Mailmap string `koanf:"mailmap"`
+
+	mailmap *model.Mailmap
+}
+
+// ParsedMailmap returns the parsed Mailmap file, nil if none is set or the configuration
+// was not loaded from a file.
+func (r CoAuthorsRule) ParsedMailmap() *model.Mailmap {
+	return r.mailmap
+}
+
+// AutosquashRule defines configuration for rejecting fixup!/squash!/amend! and work-in-progress commits.
+type AutosquashRule struct {
+	// When limits the rule to matching commits (default: all commits).
+	When *WhenRule `koanf:"when"`
+
+	// Deny lists regular expressions of work-in-progress subjects (default: WIP, tmp, do not merge, DNM).
+	Deny []string `koanf:"deny"`
+
+	// AllowOnFeatureBranches accepts leftovers unless validating against --base-branch.
+	AllowOnFeatureBranches bool `koanf:"allow-on-feature-branches"`
+}
+
+// RevertRule defines configuration for revert commit validation.
+type RevertRule struct {
+	// When limits the rule to matching commits (default: all commits).
+	When *WhenRule `koanf:"when"`
+
+	// VerifyCommit requires the reverted commit to exist in the repository (default: true).
+	VerifyCommit *bool `koanf:"verify-commit"`
+
+	// SkipSubjectRules skips the subject and conventional commit rules for revert commits.
+	SkipSubjectRules bool `koanf:"skip-subject-rules"`
+}
+
+// SensitiveContentRule defines configuration for detecting secrets in commit messages.
+type SensitiveContentRule struct {
+	// When limits the rule to matching commits (default: all commits).
+	When *WhenRule `koanf:"when"`
+
+	// Patterns maps detector names to regular expressions of sensitive values, e.g. internal hostnames.
+	Patterns map[string]string `koanf:"patterns"`
+
+	// Disable lists built-in detectors that are not run, e.g. high-entropy.
+	Disable []string `koanf:"disable"`
+
+	// MinEntropy is the entropy in bits per character from which a token counts as random (default: 4.0).
+	MinEntropy float64 `koanf:"min-entropy"`
+
+	// Allow lists regular expressions of values that are not sensitive, e.g. documented example keys.
+	Allow []string `koanf:"allow"`
+}
+
+// IssueReferenceRule defines configuration for issue tracker references.
+type IssueReferenceRule struct {
+	// When limits the rule to matching commits (default: all commits).
+	When *WhenRule `koanf:"when"`
+
+	// Trackers maps tracker names to their profile. The names jira, github, gitlab,
+	// azure-boards and linear select a built-in profile that the settings override.
+	Trackers map[string]IssueTrackerRule `koanf:"trackers"`
+
+	// CacheFile stores the issues looked up with a tracker API (default: in the user cache directory).
+	CacheFile string `koanf:"cache-file"`
+
+	// CacheTTL is how long a looked up issue is not looked up again (default: 1h).
+	CacheTTL time.Duration `koanf:"cache-ttl"`
+}
+
+// IssueTrackerRule defines the references of one issue tracker.
+type IssueTrackerRule struct {
+	// Pattern is a regular expression of a reference with the named groups "id" and "project".
+	Pattern string `koanf:"pattern"`
+
+	// Placements lists where references may appear: subject-end, subject-prefix and trailer.
+	Placements []string `koanf:"placements"`
+
+	// ClosingKeywords lists trailer keywords that close the issue, e.g. Fixes.
+	ClosingKeywords []string `koanf:"closing-keywords"`
+
+	// Projects lists the allowed projects, e.g. Jira keys or owner/repo (default: all).
+	Projects []string `koanf:"projects"`
+
+	// API, if set, verifies that referenced issues exist with the tracker's REST API.
+	API *IssueTrackerAPIRule `koanf:"api"`
+}
+
+// IssueTrackerAPIRule defines how referenced issues are looked up.
+type IssueTrackerAPIRule struct {
+	// Kind is the API: jira, github or gitlab (default: the tracker name).
+	Kind string `koanf:"kind"`
+
+	// URL is the API location, e.g. https://jira.example.com (default for github and gitlab: the hosted API).
+	URL string `koanf:"url"`
+
+	// TokenEnv names the environment variable holding the API token.
+	TokenEnv string `koanf:"token-env"`
+
+	// Project is the project of references without one, e.g. owner/repo for GitHub "#12".
+	Project string `koanf:"project"`
+
+	// Statuses lists the allowed issue statuses, e.g. [open] (default: all).
+	Statuses []string `koanf:"statuses"`
+
+	// ForbiddenStatuses lists issue statuses that are not allowed, e.g. [Closed], for
+	// trackers with many open statuses.
+	ForbiddenStatuses []string `koanf:"forbidden-statuses"`
+
+	// Timeout limits how long a lookup may take (default: 10s).
+	Timeout time.Duration `koanf:"timeout"`
+}
+
+// CustomRule declares a rule that checks part of a commit against regular expressions.
+// The target and expressions are checked when the configuration is loaded.
+type CustomRule struct {
+	// When limits the rule to matching commits (default: all commits).
+	When *WhenRule `koanf:"when"`
+
+	// Name is the rule name shown in reports and used with --rulehelp.
+	Name string `koanf:"name"`
+
+	// Target is the checked part of the commit: subject, body, trailer, author or message.
+	Target string `koanf:"target"`
+
+	// Trailer is the trailer key checked by the trailer target, e.g. Tested (default: all trailers).
+	Trailer string `koanf:"trailer"`
+
+	// MustMatch lists regular expressions that must each match the target.
+	MustMatch []string `koanf:"must-match"`
+
+	// MustNotMatch lists regular expressions that must not match the target.
+	MustNotMatch []string `koanf:"must-not-match"`
+
+	// Message is the result shown when the rule fails.
+	Message string `koanf:"message"`
+
+	// Help describes how to fix a failure.
+	Help string `koanf:"help"`
+
+	mustMatch    []*regexp.Regexp
+	mustNotMatch []*regexp.Regexp
+}
+
+// CustomRuleTargets lists the parts of a commit a custom rule can check.
+var CustomRuleTargets = []string{"subject", "body", "trailer", "author", "message"}
+
+// MustMatchRegexes returns the compiled MustMatch expressions, nil if the configuration
+// was not loaded from a file.
+func (r CustomRule) MustMatchRegexes() []*regexp.Regexp {
+	return r.mustMatch
+}
+
+// MustNotMatchRegexes returns the compiled MustNotMatch expressions, nil if the
+// configuration was not loaded from a file.
+func (r CustomRule) MustNotMatchRegexes() []*regexp.Regexp {
+	return r.mustNotMatch
+}
+
+// ExpressionRule declares a rule that checks a commit with a boolean CEL expression.
+// The expression is compiled and type-checked when the configuration is loaded.
+type ExpressionRule struct {
+	// When limits the rule to matching commits (default: all commits).
+	When *WhenRule `koanf:"when"`
+
+	// Name is the rule name shown in reports and used with --rulehelp.
+	Name string `koanf:"name"`
+
+	// Expression is true if the commit satisfies the policy, e.g. commit.parent_count < 2.
+	Expression string `koanf:"expression"`
+
+	// Message is the result shown when the rule fails.
+	Message string `koanf:"message"`
+
+	// Help describes how to fix a failure.
+	Help string `koanf:"help"`
+
+	program *expression.Program
+}
+
+// Program returns the compiled expression, nil if the configuration was not loaded from a file.
+func (r ExpressionRule) Program() *expression.Program {
+	return r.program
+}
+
+// WhenRule defines conditions under which a group of rules is active.
+// Every condition that is set must match; within one condition any pattern may match.
+type WhenRule struct {
+	// Branches lists glob patterns of the target branch, e.g. "release/*".
+	Branches []string `koanf:"branches"`
+
+	// Paths lists glob patterns of changed paths, e.g. "deploy/" or ".github/workflows/*.yml".
+	Paths []string `koanf:"paths"`
+
+	// Authors lists glob patterns matched against the author name or email, e.g. "*@example.com".
+	Authors []string `koanf:"authors"`
+}
+
+// CommitSizeRule defines the size limits of a single commit. A zero limit disables the check.
+type CommitSizeRule struct {
+	// When limits the rule to matching commits (default: all commits).
+	When *WhenRule `koanf:"when"`
+
+	// MaxFiles is the maximum number of changed files.
+	MaxFiles int `koanf:"max-files"`
+
+	// MaxLines is the maximum number of added and deleted lines.
+	MaxLines int `koanf:"max-lines"`
+
+	// MaxFileSize is the maximum size in bytes of an added or modified file.
+	MaxFileSize int64 `koanf:"max-file-size"`
+
+	// ForbidBinary rejects added or modified binary files.
+	ForbidBinary bool `koanf:"forbid-binary"`
+
+	// Exclude lists path patterns that are not counted, e.g. lock files and generated code.
+	Exclude []string `koanf:"exclude"`
+}
+
+// MergePolicyRule defines which merge commits are allowed. It applies even when
+// ignore-merge-commit skips the other rules for merge commits.
+type MergePolicyRule struct {
+	// When limits the rule to matching commits (default: all commits).
+	When *WhenRule `koanf:"when"`
+
+	// Forbid rejects all merge commits, enforcing a linear history.
+	Forbid bool `koanf:"forbid"`
+
+	// BaseBranchOnly only allows merging commits of the base branch (--base-branch, or the main branch).
+	BaseBranchOnly bool `koanf:"base-branch-only"`
+
+	// SubjectTemplate is the template merge subjects must match, e.g. "Merge branch '<source>' into <target>".
+	SubjectTemplate string `koanf:"subject-template"`
+}
+
+// TagRule defines configuration for tag validation (validate --tags).
+// Tag signatures are checked according to the signature configuration.
+type TagRule struct {
+	// Annotated enforces that tags are annotated tag objects rather than lightweight tags (default: true).
+	Annotated *bool `koanf:"annotated"`
+
+	// NamePattern is a regular expression tag names must match (default: semantic version with optional "v" prefix).
+	NamePattern string `koanf:"name-pattern"`
+
+	// MessageRequired enforces that annotated tags have a non-empty message (default: true).
+	MessageRequired *bool `koanf:"message-required"`
+}
+
+// BranchRule defines the branch naming convention (validate --branch).
+type BranchRule struct {
+	// Patterns lists regular expressions of valid branch names (empty allows all names).
+	Patterns []string `koanf:"patterns"`
+
+	// Exempt lists glob patterns of branches that are not checked (default: main, master).
+	Exempt []string `koanf:"exempt"`
+
+	// RequireJiraKey requires a Jira key such as PROJ-123 in the branch name.
+	RequireJiraKey bool `koanf:"require-jira-key"`
+
+	// MatchCommitKeys requires commit subjects that reference Jira keys to use a key of the branch name.
+	MatchCommitKeys bool `koanf:"match-commit-keys"`
+}
+
+// SignOffIdentityRule defines who must have signed off a commit.
+// The checks only apply when sign-off is enabled.
+type SignOffIdentityRule struct {
+	// When limits the SignOff rule to matching commits (default: all commits).
+	When *WhenRule `koanf:"when"`
+
+	// Author requires a sign-off matching the commit author.
+	// With --message-file the author is taken from 'git var GIT_AUTHOR_IDENT' or the git config.
+	Author bool `koanf:"author"`
+
+	// Committer requires a sign-off matching the committer.
+	Committer bool `koanf:"committer"`
+
+	// CoAuthors requires a sign-off for every Co-authored-by trailer.
+	CoAuthors bool `koanf:"co-authors"`
+
+	// IgnoreCase compares email addresses case-insensitively (default: true).
+	IgnoreCase *bool `koanf:"ignore-case"`
+
+	// IgnorePlusTag ignores "+tag" suffixes such as jane+work@example.com when comparing emails.
+	IgnorePlusTag bool `koanf:"ignore-plus-tag"`
+}
+
+// CommitIdentityRule defines the policy for commit author and committer identities.
+type CommitIdentityRule struct {
+	// When limits the rule to matching commits (default: all commits).
+	When *WhenRule `koanf:"when"`
+
+	// AllowedDomains lists email domains, including subdomains, identities must use, e.g. "example.com".
+	AllowedDomains []string `koanf:"allowed-domains"`
+
+	// AllowedPatterns lists regular expressions of allowed email addresses.
+	AllowedPatterns []string `koanf:"allowed-patterns"`
+
+	// RequireFullName rejects names consisting of a single word.
+	RequireFullName bool `koanf:"require-full-name"`
+
+	// ForbidNoreply rejects noreply addresses such as users.noreply.github.com.
+	ForbidNoreply bool `koanf:"forbid-noreply"`
+
+	// RequireSameCommitter requires the committer to be the author for non-merge commits.
+	RequireSameCommitter bool `koanf:"require-same-committer"`
+
+	// Mailmap is the path of a .mailmap file used to canonicalise identities before checking.
+	// A relative path is resolved against the root of the repository.
+	Mailmap string `koanf:"mailmap"`
+
+	mailmap *model.Mailmap
+}
+
+// ParsedMailmap returns the parsed Mailmap file, nil if none is set or the configuration
+// was not loaded from a file.
+func (r CommitIdentityRule) ParsedMailmap() *model.Mailmap {
+	return r.mailmap
 }
 
 // SignatureRule defines configuration for signature validation.
 type SignatureRule struct {
+	// When limits the rules to matching commits (default: all commits).
+	When *WhenRule `koanf:"when"`
+
 	// Identity configures identity verification for signatures.
 	Identity *IdentityRule `koanf:"identity"`
 
@@ -105,4 +604,49 @@ type SignatureRule struct {
 type IdentityRule struct {
 	// PublicKeyURI points to a file containing authorized public keys.
 	PublicKeyURI string `koanf:"public-key-uri"`
+
+	// CABundle points to a PEM file with the CA certificates trusted for X.509 (gpgsm) signatures.
+	CABundle string `koanf:"ca-bundle"`
+
+	// Sigstore configures offline verification of gitsign keyless signatures.
+	Sigstore *SigstoreRule `koanf:"sigstore"`
+
+	// KeyPolicy restricts which signing keys and hash algorithms are accepted.
+	KeyPolicy *KeyPolicyRule `koanf:"key-policy"`
+}
+
+// SigstoreRule defines the trusted root and allowed identities for Sigstore (gitsign) signatures.
+type SigstoreRule struct {
+	// FulcioRoots points to a PEM file with the trusted Fulcio root and intermediate certificates.
+	FulcioRoots string `koanf:"fulcio-roots"`
+
+	// RekorPublicKeys points to a PEM file with the trusted Rekor public keys.
+	RekorPublicKeys string `koanf:"rekor-public-keys"`
+
+	// Identities lists the OIDC identities allowed to sign commits.
+	Identities []SigstoreIdentityRule `koanf:"identities"`
+}
+
+// SigstoreIdentityRule is an allowed OIDC subject, optionally restricted to one issuer.
+type SigstoreIdentityRule struct {
+	// Subject is the email address or URI in the Fulcio certificate.
+	Subject string `koanf:"subject"`
+
+	// Issuer is the OIDC issuer URL (empty matches any issuer).
+	Issuer string `koanf:"issuer"`
+}
+
+// KeyPolicyRule defines the accepted strength and algorithms of signing keys.
+type KeyPolicyRule struct {
+	// MinRSABits is the minimum RSA key size in bits (default: 2048).
+	MinRSABits int `koanf:"min-rsa-bits"`
+
+	// MinECBits is the minimum elliptic curve key size in bits (default: 256).
+	MinECBits int `koanf:"min-ec-bits"`
+
+	// AllowedAlgorithms lists accepted key algorithms: rsa, dsa, ecdsa, ed25519, ed448 (default: rsa, ecdsa, ed25519).
+	AllowedAlgorithms []string `koanf:"allowed-algorithms"`
+
+	// AllowedHashAlgorithms lists accepted signature hashes, e.g. sha256, sha512 (default: any).
+	AllowedHashAlgorithms []string `koanf:"allowed-hash-algorithms"`
 }
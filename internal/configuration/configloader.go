@@ -6,9 +6,17 @@ package configuration
 
 import (
 	"fmt"
+	"maps"
 	"os"
 	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
 
+	"github.com/go-git/go-git/v5"
+	"github.com/itiquette/gommitlint/internal/expression"
+	"github.com/itiquette/gommitlint/internal/issuetracker"
+	"github.com/itiquette/gommitlint/internal/model"
 	"github.com/knadh/koanf/parsers/yaml"
 	"github.com/knadh/koanf/providers/file"
 	"github.com/knadh/koanf/v2"
@@ -70,6 +78,175 @@ func ReadConfigurationFile(appConfiguration *AppConf, configfile string) error {
 		return fmt.Errorf("error unmarshalling yaml config: %w", err)
 	}
 
+	if err := compileCustomRules(appConfiguration.GommitConf); err != nil {
+		return err
+	}
+
+	if err := compileExpressionRules(appConfiguration.GommitConf); err != nil {
+		return err
+	}
+
+	if err := loadMailmaps(appConfiguration.GommitConf, worktreeRoot()); err != nil {
+		return err
+	}
+
+	return checkIssueTrackerAPIs(appConfiguration.GommitConf)
+}
+
+// compileCustomRules checks the targets and compiles the regular expressions of the custom
+// rules, so that an invalid rule is reported once when the configuration is loaded.
+func compileCustomRules(config *GommitLintConfig) error {
+	if config == nil {
+		return nil
+	}
+
+	for index := range config.CustomRules {
+		customRule := &config.CustomRules[index]
+
+		name := customRule.Name
+		if name == "" {
+			name = fmt.Sprintf("#%d", index+1)
+		}
+
+		if !slices.Contains(CustomRuleTargets, customRule.Target) {
+			return fmt.Errorf("invalid target %q in custom rule %s, expected one of: %s",
+				customRule.Target, name, strings.Join(CustomRuleTargets, ", "))
+		}
+
+		var err error
+
+		if customRule.mustMatch, err = compilePatterns(customRule.MustMatch); err != nil {
+			return fmt.Errorf("invalid pattern in custom rule %s: %w", name, err)
+		}
+
+		if customRule.mustNotMatch, err = compilePatterns(customRule.MustNotMatch); err != nil {
+			return fmt.Errorf("invalid pattern in custom rule %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+// compilePatterns compiles regular expressions, stopping at the first invalid one.
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	regexes := make([]*regexp.Regexp, 0, len(patterns))
+
+	for _, pattern := range patterns {
+		patternRegex, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		regexes = append(regexes, patternRegex)
+	}
+
+	return regexes, nil
+}
+
+// compileExpressionRules compiles and type-checks the expressions of the expression rules,
+// so that an invalid expression is reported once when the configuration is loaded.
+func compileExpressionRules(config *GommitLintConfig) error {
+	if config == nil {
+		return nil
+	}
+
+	for index := range config.ExpressionRules {
+		expressionRule := &config.ExpressionRules[index]
+
+		program, err := expression.Compile(expressionRule.Expression)
+		if err != nil {
+			name := expressionRule.Name
+			if name == "" {
+				name = fmt.Sprintf("#%d", index+1)
+			}
+
+			return fmt.Errorf("invalid expression in expression rule %s: %w", name, err)
+		}
+
+		expressionRule.program = program
+	}
+
+	return nil
+}
+
+// loadMailmaps reads and parses the mailmap files of the commit identity and co-authors
+// rules once, resolving relative paths against root, so that a missing or malformed file
+// is reported when the configuration is loaded.
+func loadMailmaps(config *GommitLintConfig, root string) error {
+	if config == nil {
+		return nil
+	}
+
+	if config.CommitIdentity != nil && config.CommitIdentity.Mailmap != "" {
+		mailmap, err := model.LoadMailmap(resolvePath(root, config.CommitIdentity.Mailmap))
+		if err != nil {
+			return fmt.Errorf("invalid commit-identity mailmap: %w", err)
+		}
+
+		config.CommitIdentity.mailmap = mailmap
+	}
+
+	if config.CoAuthors != nil && config.CoAuthors.Mailmap != "" {
+		mailmap, err := model.LoadMailmap(resolvePath(root, config.CoAuthors.Mailmap))
+		if err != nil {
+			return fmt.Errorf("invalid co-authors mailmap: %w", err)
+		}
+
+		config.CoAuthors.mailmap = mailmap
+	}
+
+	return nil
+}
+
+// resolvePath returns path, joined to root if it is relative.
+func resolvePath(root, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(root, path)
+}
+
+// worktreeRoot returns the root of the repository containing the working directory,
+// or "." outside a repository.
+func worktreeRoot() string {
+	repo, err := git.PlainOpenWithOptions(".", &git.PlainOpenOptions{DetectDotGit: true})
+	if err != nil {
+		return "."
+	}
+
+	worktree, err := repo.Worktree()
+	if err != nil {
+		return "."
+	}
+
+	return worktree.Filesystem.Root()
+}
+
+// checkIssueTrackerAPIs rejects issue tracker APIs that cannot be used, such as an
+// unsupported kind or a Jira API without URL, when the configuration is loaded.
+func checkIssueTrackerAPIs(config *GommitLintConfig) error {
+	if config == nil || config.IssueReference == nil {
+		return nil
+	}
+
+	// Sorted, so that the same tracker is reported first on every run
+	for _, name := range slices.Sorted(maps.Keys(config.IssueReference.Trackers)) {
+		tracker := config.IssueReference.Trackers[name]
+		if tracker.API == nil {
+			continue
+		}
+
+		kind := tracker.API.Kind
+		if kind == "" {
+			kind = name
+		}
+
+		if err := issuetracker.CheckAPI(kind, tracker.API.URL); err != nil {
+			return fmt.Errorf("invalid api of issue tracker %s: %w", name, err)
+		}
+	}
+
 	return nil
 }
 
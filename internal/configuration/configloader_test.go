@@ -8,7 +8,10 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
+	"github.com/go-git/go-git/v5"
+	"github.com/itiquette/gommitlint/internal/model"
 	"github.com/stretchr/testify/require"
 )
 
@@ -342,3 +345,386 @@ func TestHasLocalConfigFile(t *testing.T) {
 		})
 	}
 }
+
+func TestReadIssueReferenceConfiguration(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	err := os.Chdir(tmpDir)
+	require.NoError(t, err)
+
+	content := `
+gommitlint:
+  issue-reference:
+    cache-ttl: 30m
+    trackers:
+      jira:
+        projects: [PROJ]
+        api:
+          url: https://jira.example.com
+          token-env: JIRA_TOKEN
+          statuses: [Open, In Progress]
+          timeout: 5s
+      github: {}
+`
+	err = os.WriteFile(filepath.Join(tmpDir, ".gommitlint.yaml"), []byte(content), 0600)
+	require.NoError(t, err)
+
+	appConfig := &AppConf{}
+	err = ReadConfigurationFile(appConfig, ".gommitlint.yaml")
+	require.NoError(t, err)
+
+	issueReference := appConfig.GommitConf.IssueReference
+	require.NotNil(t, issueReference)
+	require.Equal(t, 30*time.Minute, issueReference.CacheTTL)
+	require.Contains(t, issueReference.Trackers, "github")
+	require.Equal(t, []string{"PROJ"}, issueReference.Trackers["jira"].Projects)
+	require.Equal(t, &IssueTrackerAPIRule{
+		URL:      "https://jira.example.com",
+		TokenEnv: "JIRA_TOKEN",
+		Statuses: []string{"Open", "In Progress"},
+		Timeout:  5 * time.Second,
+	}, issueReference.Trackers["jira"].API)
+}
+
+func TestReadCustomRulesConfiguration(t *testing.T) {
+	tmpDir := t.TempDir()
+
+	err := os.Chdir(tmpDir)
+	require.NoError(t, err)
+
+	content := `
+gommitlint:
+  custom-rules:
+    - name: NoSkipCI
+      target: subject
+      must-not-match: '\[skip ci\]'
+      message: Do not skip CI on main
+      when:
+        branches: [main]
+    - name: Tested
+      target: trailer
+      trailer: Tested
+      must-match: ['.+']
+      help: Add a "Tested:" trailer describing how the change was tested.
+`
+	err = os.WriteFile(filepath.Join(tmpDir, ".gommitlint.yaml"), []byte(content), 0600)
+	require.NoError(t, err)
+
+	appConfig := &AppConf{}
+	err = ReadConfigurationFile(appConfig, ".gommitlint.yaml")
+	require.NoError(t, err)
+
+	customRules := appConfig.GommitConf.CustomRules
+	require.Len(t, customRules, 2)
+	require.Empty(t, customRules[0].MustMatchRegexes())
+	require.Len(t, customRules[0].MustNotMatchRegexes(), 1)
+	require.Equal(t, `\[skip ci\]`, customRules[0].MustNotMatchRegexes()[0].String())
+	require.Len(t, customRules[1].MustMatchRegexes(), 1)
+	require.Equal(t, ".+", customRules[1].MustMatchRegexes()[0].String())
+
+	// The compiled expressions are checked above
+	for index := range customRules {
+		customRules[index].mustMatch = nil
+		customRules[index].mustNotMatch = nil
+	}
+
+	require.Equal(t, []CustomRule{
+		{
+			When:         &WhenRule{Branches: []string{"main"}},
+			Name:         "NoSkipCI",
+			Target:       "subject",
+			MustNotMatch: []string{`\[skip ci\]`},
+			Message:      "Do not skip CI on main",
+		},
+		{
+			Name:      "Tested",
+			Target:    "trailer",
+			Trailer:   "Tested",
+			MustMatch: []string{".+"},
+			Help:      `Add a "Tested:" trailer describing how the change was tested.`,
+		},
+	}, appConfig.GommitConf.CustomRules)
+}
+
+func TestReadInvalidCustomRulesConfiguration(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		errContains string
+	}{
+		{
+			name: "Unknown target",
+			content: `
+gommitlint:
+  custom-rules:
+    - name: NoWip
+      target: title
+      must-not-match: 'WIP'
+`,
+			errContains: `invalid target "title" in custom rule NoWip`,
+		},
+		{
+			name: "Invalid must-match pattern",
+			content: `
+gommitlint:
+  custom-rules:
+    - target: body
+      must-match: ['(unclosed']
+`,
+			errContains: "invalid pattern in custom rule #1",
+		},
+		{
+			name: "Invalid must-not-match pattern",
+			content: `
+gommitlint:
+  custom-rules:
+    - name: NoFixup
+      target: subject
+      must-not-match: '[fixup'
+`,
+			errContains: "invalid pattern in custom rule NoFixup",
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+
+			err := os.Chdir(tmpDir)
+			require.NoError(t, err)
+
+			err = os.WriteFile(filepath.Join(tmpDir, ".gommitlint.yaml"), []byte(tabletest.content), 0600)
+			require.NoError(t, err)
+
+			appConfig := &AppConf{}
+			err = ReadConfigurationFile(appConfig, ".gommitlint.yaml")
+			require.ErrorContains(t, err, tabletest.errContains)
+		})
+	}
+}
+
+func TestReadExpressionRulesConfiguration(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		errContains string
+	}{
+		{
+			name: "Valid expression",
+			content: `
+gommitlint:
+  expression-rules:
+    - name: ApiFeaturesReferenceIssues
+      expression: '!(commit.type == "feat" && "api" in commit.scopes) || "Refs" in commit.trailers'
+      message: API features must reference an issue
+`,
+		},
+		{
+			name: "Unknown field",
+			content: `
+gommitlint:
+  expression-rules:
+    - name: NoMerges
+      expression: 'commit.parents < 2'
+`,
+			errContains: "invalid expression in expression rule NoMerges",
+		},
+		{
+			name: "Not a boolean",
+			content: `
+gommitlint:
+  expression-rules:
+    - expression: 'commit.subject'
+`,
+			errContains: "invalid expression in expression rule #1",
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+
+			err := os.Chdir(tmpDir)
+			require.NoError(t, err)
+
+			err = os.WriteFile(filepath.Join(tmpDir, ".gommitlint.yaml"), []byte(tabletest.content), 0600)
+			require.NoError(t, err)
+
+			appConfig := &AppConf{}
+			err = ReadConfigurationFile(appConfig, ".gommitlint.yaml")
+
+			if tabletest.errContains != "" {
+				require.ErrorContains(t, err, tabletest.errContains)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			expressionRules := appConfig.GommitConf.ExpressionRules
+			require.Len(t, expressionRules, 1)
+			require.Equal(t, "ApiFeaturesReferenceIssues", expressionRules[0].Name)
+			require.Equal(t, "API features must reference an issue", expressionRules[0].Message)
+			require.NotNil(t, expressionRules[0].Program())
+			require.Equal(t, expressionRules[0].Expression, expressionRules[0].Program().String())
+		})
+	}
+}
+
+func TestReadIssueTrackerAPIConfiguration(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		errContains string
+	}{
+		{
+			name: "Hosted GitHub API",
+			content: `
+gommitlint:
+  issue-reference:
+    trackers:
+      github:
+        api:
+          project: owner/repo
+`,
+		},
+		{
+			name: "Unsupported kind",
+			content: `
+gommitlint:
+  issue-reference:
+    trackers:
+      linear:
+        api:
+          url: https://api.linear.app
+`,
+			errContains: "invalid api of issue tracker linear: unsupported issue tracker API",
+		},
+		{
+			name: "Jira without URL",
+			content: `
+gommitlint:
+  issue-reference:
+    trackers:
+      jira:
+        api:
+          token-env: JIRA_TOKEN
+`,
+			errContains: "invalid api of issue tracker jira: the jira issue tracker API needs a URL",
+		},
+		{
+			// The trackers are checked in order of their names
+			name: "Several invalid trackers",
+			content: `
+gommitlint:
+  issue-reference:
+    trackers:
+      youtrack:
+        api:
+          url: https://youtrack.example.com
+      jira:
+        api:
+          forbidden-statuses: [Closed]
+      linear:
+        api:
+          url: https://api.linear.app
+`,
+			errContains: "invalid api of issue tracker jira:",
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+
+			err := os.Chdir(tmpDir)
+			require.NoError(t, err)
+
+			err = os.WriteFile(filepath.Join(tmpDir, ".gommitlint.yaml"), []byte(tabletest.content), 0600)
+			require.NoError(t, err)
+
+			appConfig := &AppConf{}
+			err = ReadConfigurationFile(appConfig, ".gommitlint.yaml")
+
+			if tabletest.errContains != "" {
+				require.ErrorContains(t, err, tabletest.errContains)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestReadMailmapConfiguration(t *testing.T) {
+	tests := []struct {
+		name        string
+		mailmap     string
+		errContains string
+	}{
+		{
+			name:    "Valid mailmap",
+			mailmap: "Jane Doe <jane@example.com> <jane.doe@gmail.com>\n",
+		},
+		{
+			name:        "Missing mailmap",
+			errContains: "invalid commit-identity mailmap",
+		},
+		{
+			name:        "Malformed mailmap",
+			mailmap:     "Jane Doe jane@example.com\n",
+			errContains: "invalid commit-identity mailmap",
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			tmpDir := t.TempDir()
+
+			_, err := git.PlainInit(tmpDir, false)
+			require.NoError(t, err)
+
+			if tabletest.mailmap != "" {
+				err = os.WriteFile(filepath.Join(tmpDir, ".mailmap"), []byte(tabletest.mailmap), 0600)
+				require.NoError(t, err)
+			}
+
+			// The mailmap is found from a subdirectory of the repository
+			subDir := filepath.Join(tmpDir, "services", "billing")
+			require.NoError(t, os.MkdirAll(subDir, 0755))
+
+			err = os.Chdir(subDir)
+			require.NoError(t, err)
+
+			content := `
+gommitlint:
+  commit-identity:
+    mailmap: .mailmap
+  co-authors:
+    mailmap: .mailmap
+`
+			err = os.WriteFile(".gommitlint.yaml", []byte(content), 0600)
+			require.NoError(t, err)
+
+			appConfig := &AppConf{}
+			err = ReadConfigurationFile(appConfig, ".gommitlint.yaml")
+
+			if tabletest.errContains != "" {
+				require.ErrorContains(t, err, tabletest.errContains)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			for _, mailmap := range []*model.Mailmap{
+				appConfig.GommitConf.CommitIdentity.ParsedMailmap(),
+				appConfig.GommitConf.CoAuthors.ParsedMailmap(),
+			} {
+				require.NotNil(t, mailmap)
+				require.True(t, mailmap.Contains("jane.doe@gmail.com"))
+			}
+		})
+	}
+}
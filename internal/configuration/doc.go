@@ -58,5 +58,63 @@
 //	    scopes:
 //	      - ui
 //	      - backend
+//	    scope-paths:
+//	      scopes:
+//	        ui: ["web/**"]
+//	        backend: ["services/", "*.proto"]
+//	      ignore: ["go.sum"]
+//	    type-content:
+//	      categories:
+//	        docs: ["docs/", "*.md"]
+//	      exempt: [chore, revert]
+//	  banned-terms:
+//	    inclusive: true
+//	    terms:
+//	      - term: acme corp
+//	        suggestions: [the customer]
+//	    allow-in: [code, urls]
+//	  issue-reference:
+//	    trackers:
+//	      jira:
+//	        projects: [PROJ, OPS]
+//	        api:
+//	          url: https://jira.example.com
+//	          token-env: JIRA_TOKEN
+//	          forbidden-statuses: [Closed, Done]
+//	      github:
+//	        placements: [subject-end, trailer]
+//	        closing-keywords: [Fixes, Closes]
+//	    cache-ttl: 1h
+//	  commit-size:
+//	    max-files: 50
+//	    max-lines: 1000
+//	    max-file-size: 1048576
+//	    forbid-binary: true
+//	    exclude: ["go.sum", "**/generated/"]
+//	  merge-policy:
+//	    base-branch-only: true
+//	    subject-template: "Merge branch '<source>' into <target>"
+//	  branch:
+//	    patterns: ['^(feat|fix|chore)/[A-Z]+-\d+-[a-z0-9-]+$']
+//	    exempt: [main, "release/*"]
+//	    match-commit-keys: true
+//	  sensitive-content:
+//	    patterns:
+//	      internal-host: '\b[a-z0-9-]+\.corp\.example\.com\b'
+//	    allow: ['EXAMPLE$']
 //	  sign-off: true
+//	  sign-off-identity:
+//	    author: true
+//	    co-authors: true
+//	  custom-rules:
+//	    - name: NoSkipCI
+//	      target: subject
+//	      must-not-match: '\[skip ci\]'
+//	      message: Do not skip CI on main
+//	      when:
+//	        branches: [main]
+//	  expression-rules:
+//	    - name: ApiFeaturesReferenceIssues
+//	      expression: '!(commit.type == "feat" && "api" in commit.scopes) || "Refs" in commit.trailers'
+//	      message: Features in the api scope must reference an issue
 package configuration
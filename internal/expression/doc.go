@@ -0,0 +1,36 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+/*
+Package expression compiles and evaluates policy expressions over commit data.
+
+Expressions are written in the Common Expression Language (CEL, https://cel.dev) and
+must evaluate to a boolean: true if the commit satisfies the policy. They see a single
+variable, commit, with the fields:
+
+  - subject, body, message: the subject line, the message after it, the full message
+  - conventional: whether the subject is a conventional commit subject
+  - type, scopes, breaking, description: the parts of a conventional commit
+  - trailers: the trailer values by key, e.g. commit.trailers["Signed-off-by"]; keys
+    are case-insensitive and written with an upper case first letter and the rest in
+    lower case (see TrailerKey), so "Co-Authored-By" is found as "Co-authored-by"
+  - author.name, author.email: the commit author
+  - paths_known: whether changed_paths and parent_count are known; they are not for a
+    commit message file, whose changed_paths is empty and parent_count 0
+  - changed_paths: the paths changed compared to the first parent
+  - parent_count: the number of parents
+
+Expressions are type-checked when they are compiled, so that a misspelled field or a
+comparison of a string with a number is reported before any commit is validated.
+
+Usage Example
+
+	program, err := expression.Compile(`commit.type != "feat" || "Refs" in commit.trailers`)
+	if err != nil {
+		return err
+	}
+
+	satisfied, err := program.Eval(expression.Commit{Subject: "feat: add login", Type: "feat"})
+*/
+package expression
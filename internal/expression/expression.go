@@ -0,0 +1,119 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package expression
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/ext"
+	"github.com/itiquette/gommitlint/internal/model"
+)
+
+// Commit is the commit an expression is evaluated against, the commit variable.
+type Commit struct {
+	Subject      string              `cel:"subject"`       // First line of the message
+	Body         string              `cel:"body"`          // Message after the subject
+	Message      string              `cel:"message"`       // Full message
+	Conventional bool                `cel:"conventional"`  // Whether the subject is a conventional commit subject
+	Type         string              `cel:"type"`          // Conventional type, e.g. "feat"
+	Scopes       []string            `cel:"scopes"`        // Conventional scopes
+	Breaking     bool                `cel:"breaking"`      // Whether the commit declares a breaking change
+	Description  string              `cel:"description"`   // Conventional description
+	Trailers     map[string][]string `cel:"trailers"`      // Trailer values by key in TrailerKey case
+	Author       model.Identity      `cel:"author"`        // Author, empty if unknown
+	PathsKnown   bool                `cel:"paths_known"`   // Whether changed_paths and parent_count are known
+	ChangedPaths []string            `cel:"changed_paths"` // Changed paths, empty if unknown
+	ParentCount  int                 `cel:"parent_count"`  // Number of parents, 0 if unknown
+}
+
+// TrailerKey returns the key under which a trailer is found in Commit.Trailers. Trailer
+// keys are case-insensitive, so they are stored with an upper case first letter and the
+// rest in lower case: "Signed-Off-By" and "signed-off-by" are both "Signed-off-by".
+func TrailerKey(key string) string {
+	if key == "" {
+		return ""
+	}
+
+	return strings.ToUpper(key[:1]) + strings.ToLower(key[1:])
+}
+
+// environment returns the CEL environment shared by all expressions.
+var environment = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(
+		ext.NativeTypes(reflect.TypeOf(Commit{}), ext.ParseStructField(celFieldName)),
+		ext.Strings(),
+		cel.Variable("commit", cel.ObjectType("expression.Commit")),
+	)
+})
+
+// celFieldName returns the name of a struct field in expressions: its cel tag, or for
+// types without tags such as model.Identity, the lower case field name.
+func celFieldName(field reflect.StructField) string {
+	if name, tagged := field.Tag.Lookup("cel"); tagged {
+		return name
+	}
+
+	return strings.ToLower(field.Name)
+}
+
+// Program is a compiled and type-checked expression.
+type Program struct {
+	expression string
+	program    cel.Program
+}
+
+// Compile parses and type-checks an expression, which must evaluate to a boolean.
+func Compile(expression string) (*Program, error) {
+	env, err := environment()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create expression environment: %w", err)
+	}
+
+	if expression == "" {
+		return nil, errors.New("empty expression")
+	}
+
+	checked, issues := env.Compile(expression)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	if !checked.OutputType().IsExactType(cel.BoolType) {
+		return nil, fmt.Errorf("expression evaluates to %s, expected bool", checked.OutputType())
+	}
+
+	program, err := env.Program(checked)
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare expression: %w", err)
+	}
+
+	return &Program{expression: expression, program: program}, nil
+}
+
+// String returns the expression as written.
+func (p *Program) String() string {
+	return p.expression
+}
+
+// Eval evaluates the expression against a commit. It returns an error if the evaluation
+// fails, e.g. when a trailer that the commit does not have is indexed.
+func (p *Program) Eval(commit Commit) (bool, error) {
+	value, _, err := p.program.Eval(map[string]any{"commit": commit})
+	if err != nil {
+		return false, err
+	}
+
+	satisfied, isBool := value.Value().(bool)
+	if !isBool {
+		return false, fmt.Errorf("expression evaluated to %v, expected bool", value)
+	}
+
+	return satisfied, nil
+}
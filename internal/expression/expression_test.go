@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package expression
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompile(t *testing.T) {
+	tests := []struct {
+		name        string
+		expression  string
+		errContains string
+	}{
+		{
+			name:       "Boolean expression",
+			expression: `commit.type == "feat" && commit.parent_count == 1`,
+		},
+		{
+			name:       "String extension functions",
+			expression: `commit.subject.lowerAscii().contains("wip") == false`,
+		},
+		{
+			name:        "Empty expression",
+			expression:  "",
+			errContains: "empty expression",
+		},
+		{
+			name:        "Syntax error",
+			expression:  `commit.type ==`,
+			errContains: "Syntax error",
+		},
+		{
+			name:        "Unknown field",
+			expression:  `commit.kind == "feat"`,
+			errContains: "undefined field 'kind'",
+		},
+		{
+			name:        "Type mismatch",
+			expression:  `commit.parent_count == "1"`,
+			errContains: "no matching overload",
+		},
+		{
+			name:        "Not a boolean",
+			expression:  `commit.subject`,
+			errContains: "evaluates to string, expected bool",
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			program, err := Compile(tabletest.expression)
+
+			if tabletest.errContains != "" {
+				require.ErrorContains(t, err, tabletest.errContains)
+				require.Nil(t, program)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tabletest.expression, program.String())
+		})
+	}
+}
+
+func TestEval(t *testing.T) {
+	commit := Commit{
+		Subject:      "feat(api): add login endpoint",
+		Body:         "Adds the endpoint.\n\nRefs: #12",
+		Conventional: true,
+		Type:         "feat",
+		Scopes:       []string{"api"},
+		Description:  "add login endpoint",
+		Trailers:     map[string][]string{"Refs": {"#12"}},
+		Author:       model.Identity{Name: "Laval Lion", Email: "laval@cavora.org"},
+		PathsKnown:   true,
+		ChangedPaths: []string{"api/login.go", "api/login_test.go"},
+		ParentCount:  1,
+	}
+
+	tests := []struct {
+		name        string
+		expression  string
+		commit      Commit
+		expected    bool
+		errContains string
+	}{
+		{
+			name:       "Feature in api scope references an issue",
+			expression: `!(commit.type == "feat" && "api" in commit.scopes) || "Refs" in commit.trailers`,
+			commit:     commit,
+			expected:   true,
+		},
+		{
+			name:       "Feature in api scope without reference",
+			expression: `!(commit.type == "feat" && "api" in commit.scopes) || "Refs" in commit.trailers`,
+			commit:     Commit{Conventional: true, Type: "feat", Scopes: []string{"api"}},
+			expected:   false,
+		},
+		{
+			name:       "Changed paths",
+			expression: `commit.changed_paths.all(p, p.startsWith("api/"))`,
+			commit:     commit,
+			expected:   true,
+		},
+		{
+			name:       "Author email",
+			expression: `commit.author.email.endsWith("@cavora.org")`,
+			commit:     commit,
+			expected:   true,
+		},
+		{
+			name:       "Unknown paths are told apart from no paths",
+			expression: `!commit.paths_known || size(commit.changed_paths) > 0`,
+			commit:     Commit{},
+			expected:   true,
+		},
+		{
+			name:       "Merge commit",
+			expression: `commit.parent_count <= 1`,
+			commit:     Commit{ParentCount: 2},
+			expected:   false,
+		},
+		{
+			name:       "Empty commit",
+			expression: `size(commit.scopes) == 0 && size(commit.trailers) == 0 && !commit.breaking`,
+			commit:     Commit{},
+			expected:   true,
+		},
+		{
+			name:        "Missing trailer",
+			expression:  `commit.trailers["Tested"][0] != ""`,
+			commit:      commit,
+			errContains: "no such key",
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			program, err := Compile(tabletest.expression)
+			require.NoError(t, err)
+
+			satisfied, err := program.Eval(tabletest.commit)
+
+			if tabletest.errContains != "" {
+				require.ErrorContains(t, err, tabletest.errContains)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tabletest.expected, satisfied)
+		})
+	}
+}
+
+func TestTrailerKey(t *testing.T) {
+	for key, expected := range map[string]string{
+		"Signed-off-by":  "Signed-off-by",
+		"Signed-Off-By":  "Signed-off-by",
+		"signed-off-by":  "Signed-off-by",
+		"CO-AUTHORED-BY": "Co-authored-by",
+		"Refs":           "Refs",
+		"":               "",
+	} {
+		require.Equal(t, expected, TrailerKey(key), key)
+	}
+}
@@ -0,0 +1,66 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package git
+
+import "strings"
+
+// ciTargetBranchVariables lists the environment variables CI systems set to the branch a
+// pull request is merged into, in order of precedence.
+var ciTargetBranchVariables = []string{
+	"GITHUB_BASE_REF",                     // GitHub Actions pull requests
+	"CI_MERGE_REQUEST_TARGET_BRANCH_NAME", // GitLab merge requests
+	"CHANGE_TARGET",                       // Jenkins multibranch pull requests
+}
+
+// ciBranchVariables lists the environment variables CI systems set to the branch being
+// built, in order of precedence. Source branches of pull requests come first, since CI
+// systems check out a detached merge commit for them.
+var ciBranchVariables = []string{
+	"GITHUB_HEAD_REF",                     // GitHub Actions pull requests
+	"CI_MERGE_REQUEST_SOURCE_BRANCH_NAME", // GitLab merge requests
+	"CHANGE_BRANCH",                       // Jenkins multibranch pull requests
+	"GITHUB_REF",                          // GitHub Actions pushes, e.g. refs/heads/main
+	"CI_COMMIT_BRANCH",                    // GitLab branch pipelines
+	"BITBUCKET_BRANCH",                    // Bitbucket Pipelines
+	"BUILDKITE_BRANCH",                    // Buildkite
+	"CIRCLE_BRANCH",                       // CircleCI
+	"BRANCH_NAME",                         // Jenkins multibranch pipelines
+}
+
+// CIBranch returns the branch a CI system is building, read with getenv (e.g. os.Getenv),
+// or "" if no CI branch variable is set. GITHUB_REF is only used for branch refs.
+func CIBranch(getenv func(string) string) string {
+	for _, variable := range ciBranchVariables {
+		value := getenv(variable)
+		if value == "" {
+			continue
+		}
+
+		if variable == "GITHUB_REF" {
+			branch, ok := strings.CutPrefix(value, "refs/heads/")
+			if !ok {
+				continue
+			}
+
+			value = branch
+		}
+
+		return value
+	}
+
+	return ""
+}
+
+// CITargetBranch returns the branch a CI pull request is merged into, read with getenv
+// (e.g. os.Getenv), or "" if no CI target branch variable is set.
+func CITargetBranch(getenv func(string) string) string {
+	for _, variable := range ciTargetBranchVariables {
+		if value := getenv(variable); value != "" {
+			return value
+		}
+	}
+
+	return ""
+}
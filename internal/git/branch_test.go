@@ -0,0 +1,94 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package git
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCIBranch(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected string
+	}{
+		{
+			name: "no CI",
+		},
+		{
+			name:     "GitHub pull request",
+			env:      map[string]string{"GITHUB_HEAD_REF": "feat/PROJ-1-login", "GITHUB_REF": "refs/pull/7/merge"},
+			expected: "feat/PROJ-1-login",
+		},
+		{
+			name:     "GitHub push",
+			env:      map[string]string{"GITHUB_HEAD_REF": "", "GITHUB_REF": "refs/heads/feat/PROJ-1-login"},
+			expected: "feat/PROJ-1-login",
+		},
+		{
+			name: "GitHub tag push",
+			env:  map[string]string{"GITHUB_REF": "refs/tags/v1.0.0"},
+		},
+		{
+			name:     "GitLab merge request",
+			env:      map[string]string{"CI_MERGE_REQUEST_SOURCE_BRANCH_NAME": "fix/PROJ-2", "CI_COMMIT_BRANCH": "main"},
+			expected: "fix/PROJ-2",
+		},
+		{
+			name:     "Jenkins",
+			env:      map[string]string{"BRANCH_NAME": "feat/PROJ-3"},
+			expected: "feat/PROJ-3",
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			require.Equal(t, tabletest.expected, CIBranch(func(key string) string {
+				return tabletest.env[key]
+			}))
+		})
+	}
+}
+
+func TestCITargetBranch(t *testing.T) {
+	tests := []struct {
+		name     string
+		env      map[string]string
+		expected string
+	}{
+		{
+			name: "no CI",
+		},
+		{
+			name:     "GitHub pull request",
+			env:      map[string]string{"GITHUB_BASE_REF": "release/1.0", "GITHUB_HEAD_REF": "feat/PROJ-1-login"},
+			expected: "release/1.0",
+		},
+		{
+			name: "GitHub push",
+			env:  map[string]string{"GITHUB_BASE_REF": "", "GITHUB_REF": "refs/heads/main"},
+		},
+		{
+			name:     "GitLab merge request",
+			env:      map[string]string{"CI_MERGE_REQUEST_TARGET_BRANCH_NAME": "main", "CI_COMMIT_BRANCH": "fix/PROJ-2"},
+			expected: "main",
+		},
+		{
+			name:     "Jenkins pull request",
+			env:      map[string]string{"CHANGE_TARGET": "release/2.0", "CHANGE_BRANCH": "feat/PROJ-3"},
+			expected: "release/2.0",
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			require.Equal(t, tabletest.expected, CITargetBranch(func(key string) string {
+				return tabletest.env[key]
+			}))
+		})
+	}
+}
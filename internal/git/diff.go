@@ -0,0 +1,244 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package git
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strings"
+
+	fdiff "github.com/go-git/go-git/v5/plumbing/format/diff"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// FileChange describes how a commit changed a single file compared to its first parent.
+type FileChange struct {
+	Path      string // Path after the change, or the removed path for deleted files
+	OldPath   string // Path before the change, empty for added files
+	Additions int    // Number of added lines, only counted with ChangeOptions.Lines
+	Deletions int    // Number of deleted lines, only counted with ChangeOptions.Lines
+	IsBinary  bool   // Whether the file is binary (line counts are zero), see ChangeOptions.Binary
+	IsDeleted bool   // Whether the file was deleted
+	Size      int64  // Size of the file after the change in bytes, 0 for deleted files
+}
+
+// ChangeOptions selects the details CommitChanges computes for each file.
+type ChangeOptions struct {
+	Lines   bool                   // Count added and deleted lines, which needs a patch per file
+	Binary  bool                   // Detect binary files (always done when counting lines)
+	Exclude func(path string) bool // Skips files it returns true for, nil keeps all files
+}
+
+// CommitChanges returns the files changed by a commit compared to its first parent.
+// A root commit is compared to the empty tree. Renames are detected and reported
+// as a single change with OldPath set.
+//
+// Patches are only computed when options.Lines is set, and never for excluded files.
+func CommitChanges(commit *object.Commit, options ChangeOptions) ([]FileChange, error) {
+	changes, err := diffCommit(commit, object.DefaultDiffTreeOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	fileChanges := make([]FileChange, 0, len(changes))
+
+	for _, change := range changes {
+		fileChange := newFileChange(change)
+		if options.Exclude != nil && options.Exclude(fileChange.Path) {
+			continue
+		}
+
+		_, to, err := change.Files()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read changed file: %w", err)
+		}
+
+		if to != nil {
+			fileChange.Size = to.Size
+		}
+
+		switch {
+		case options.Lines:
+			patch, err := change.Patch()
+			if err != nil {
+				return nil, fmt.Errorf("failed to create patch: %w", err)
+			}
+
+			for _, filePatch := range patch.FilePatches() {
+				countLines(&fileChange, filePatch)
+			}
+		case options.Binary && to != nil:
+			fileChange.IsBinary, err = to.IsBinary()
+			if err != nil {
+				return nil, fmt.Errorf("failed to read changed file: %w", err)
+			}
+		}
+
+		fileChanges = append(fileChanges, fileChange)
+	}
+
+	return fileChanges, nil
+}
+
+// CommitPaths returns the paths a commit changes compared to its first parent,
+// including both sides of renames. Only the trees are compared, no file content is read.
+func CommitPaths(commit *object.Commit) ([]string, error) {
+	// Without rename detection a rename is listed as a removal and an addition
+	changes, err := diffCommit(commit, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(changes))
+
+	for _, change := range changes {
+		if change.To.Name != "" {
+			paths = append(paths, change.To.Name)
+		}
+
+		if change.From.Name != "" && change.From.Name != change.To.Name {
+			paths = append(paths, change.From.Name)
+		}
+	}
+
+	return paths, nil
+}
+
+// diffCommit compares the tree of a commit to the tree of its first parent,
+// or to the empty tree for a root commit.
+func diffCommit(commit *object.Commit, options *object.DiffTreeOptions) (object.Changes, error) {
+	if commit == nil {
+		return nil, errors.New("commit cannot be nil")
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get commit tree: %w", err)
+	}
+
+	var parentTree *object.Tree
+
+	if commit.NumParents() > 0 {
+		parent, err := commit.Parent(0)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get parent commit: %w", err)
+		}
+
+		parentTree, err = parent.Tree()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get parent tree: %w", err)
+		}
+	}
+
+	changes, err := object.DiffTreeWithOptions(context.Background(), parentTree, tree, options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to diff commit: %w", err)
+	}
+
+	return changes, nil
+}
+
+// MatchPath reports whether a repository path matches a path pattern.
+//
+// Patterns follow a small subset of gitignore semantics:
+//   - "deploy/" and "deploy/**" match everything below the deploy directory; wildcards in
+//     the directory match a single path segment, e.g. "services/*/api/"
+//   - a pattern without a slash, e.g. "*.tf", matches the file name in any directory
+//   - a leading "**/", e.g. "**/testdata/", matches the rest of the pattern in any directory
+//   - any other pattern is matched against the full path with path.Match
+func MatchPath(pattern, changedPath string) bool {
+	if rest, ok := strings.CutPrefix(pattern, "**/"); ok {
+		for dir := changedPath; ; {
+			if MatchPath(rest, dir) {
+				return true
+			}
+
+			_, after, found := strings.Cut(dir, "/")
+			if !found {
+				return false
+			}
+
+			dir = after
+		}
+	}
+
+	pattern = strings.TrimPrefix(pattern, "/")
+
+	if dir, ok := strings.CutSuffix(pattern, "/**"); ok {
+		pattern = dir + "/"
+	}
+
+	if dir, ok := strings.CutSuffix(pattern, "/"); ok {
+		return matchDir(dir, changedPath)
+	}
+
+	if !strings.Contains(pattern, "/") {
+		matched, _ := path.Match(pattern, path.Base(changedPath))
+
+		return matched
+	}
+
+	matched, _ := path.Match(pattern, changedPath)
+
+	return matched
+}
+
+// matchDir reports whether a repository path lies below a directory matching the pattern.
+// The pattern is matched segment by segment, so that a wildcard never crosses a slash.
+func matchDir(pattern, changedPath string) bool {
+	dirSegments := strings.Split(pattern, "/")
+	pathSegments := strings.Split(changedPath, "/")
+
+	if len(pathSegments) <= len(dirSegments) {
+		return false
+	}
+
+	for index, segment := range dirSegments {
+		if matched, _ := path.Match(segment, pathSegments[index]); !matched {
+			return false
+		}
+	}
+
+	return true
+}
+
+// newFileChange describes the paths of a tree change as a FileChange.
+func newFileChange(change *object.Change) FileChange {
+	switch {
+	case change.From.Name == "":
+		return FileChange{Path: change.To.Name}
+	case change.To.Name == "":
+		return FileChange{Path: change.From.Name, OldPath: change.From.Name, IsDeleted: true}
+	default:
+		return FileChange{Path: change.To.Name, OldPath: change.From.Name}
+	}
+}
+
+// countLines adds the line counts of a file patch to the change.
+func countLines(change *FileChange, filePatch fdiff.FilePatch) {
+	change.IsBinary = filePatch.IsBinary()
+
+	for _, chunk := range filePatch.Chunks() {
+		content := chunk.Content()
+		if content == "" {
+			continue
+		}
+
+		lines := strings.Count(content, "\n")
+		if !strings.HasSuffix(content, "\n") {
+			lines++
+		}
+
+		switch chunk.Type() {
+		case fdiff.Add:
+			change.Additions += lines
+		case fdiff.Delete:
+			change.Deletions += lines
+		case fdiff.Equal:
+		}
+	}
+}
@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package git
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitChanges(t *testing.T) {
+	repo := setupTestRepo(t, t.TempDir())
+
+	worktree, err := repo.Repo.Worktree()
+	require.NoError(t, err)
+
+	root := worktree.Filesystem.Root()
+
+	// Root commit is compared to the empty tree
+	head, err := repo.Repo.Head()
+	require.NoError(t, err)
+
+	rootCommit, err := repo.Repo.CommitObject(head.Hash())
+	require.NoError(t, err)
+
+	changes, err := CommitChanges(rootCommit, ChangeOptions{Lines: true})
+	require.NoError(t, err)
+	require.Equal(t, []FileChange{{Path: "file1.txt", Additions: 1, Size: 14}}, changes)
+
+	paths, err := CommitPaths(rootCommit)
+	require.NoError(t, err)
+	require.Equal(t, []string{"file1.txt"}, paths)
+
+	// Modify, add, delete and rename files in a second commit
+	renamedContent := "line 1\nline 2\nline 3\nline 4\nline 5\n"
+	createAndCommitFile(t, worktree, "old.txt", renamedContent)
+	createAndCommitFile(t, worktree, "gone.txt", "removed\n")
+
+	require.NoError(t, os.WriteFile(filepath.Join(root, "file1.txt"), []byte("Changed\nAdded line\n"), 0600))
+	require.NoError(t, os.MkdirAll(filepath.Join(root, "deploy"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "deploy", "app.yaml"), []byte("a: 1\nb: 2\n"), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(root, "logo.bin"), []byte{0x89, 0x00, 0x01, 0x02}, 0600))
+	require.NoError(t, os.Rename(filepath.Join(root, "old.txt"), filepath.Join(root, "new.txt")))
+	require.NoError(t, os.Remove(filepath.Join(root, "gone.txt")))
+
+	// Adding the root also stages the removal of old.txt
+	_, err = worktree.Add(".")
+	require.NoError(t, err)
+
+	hash, err := worktree.Commit("Second commit", &git.CommitOptions{
+		Author: &object.Signature{Name: "Test User", Email: "test@example.com"},
+	})
+	require.NoError(t, err)
+
+	commit, err := repo.Repo.CommitObject(hash)
+	require.NoError(t, err)
+
+	changes, err = CommitChanges(commit, ChangeOptions{Lines: true})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []FileChange{
+		{Path: "deploy/app.yaml", Additions: 2, Size: 10},
+		{Path: "file1.txt", OldPath: "file1.txt", Additions: 2, Deletions: 1, Size: 19},
+		{Path: "gone.txt", OldPath: "gone.txt", Deletions: 1, IsDeleted: true},
+		{Path: "logo.bin", IsBinary: true, Size: 4},
+		{Path: "new.txt", OldPath: "old.txt", Size: 35},
+	}, changes)
+
+	// Without lines no patch is computed, binaries are still detected on request
+	changes, err = CommitChanges(commit, ChangeOptions{
+		Binary:  true,
+		Exclude: func(path string) bool { return path == "file1.txt" },
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []FileChange{
+		{Path: "deploy/app.yaml", Size: 10},
+		{Path: "gone.txt", OldPath: "gone.txt", IsDeleted: true},
+		{Path: "logo.bin", IsBinary: true, Size: 4},
+		{Path: "new.txt", OldPath: "old.txt", Size: 35},
+	}, changes)
+
+	paths, err = CommitPaths(commit)
+	require.NoError(t, err)
+	require.ElementsMatch(t,
+		[]string{"deploy/app.yaml", "file1.txt", "gone.txt", "logo.bin", "new.txt", "old.txt"},
+		paths)
+
+	_, err = CommitChanges(nil, ChangeOptions{})
+	require.Error(t, err)
+
+	_, err = CommitPaths(nil)
+	require.Error(t, err)
+}
+
+func TestMatchPath(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		path     string
+		expected bool
+	}{
+		{pattern: "deploy/", path: "deploy/app.yaml", expected: true},
+		{pattern: "deploy/", path: "deploy/prod/app.yaml", expected: true},
+		{pattern: "/deploy/", path: "deploy/app.yaml", expected: true},
+		{pattern: "deploy/**", path: "deploy/prod/app.yaml", expected: true},
+		{pattern: "deploy/", path: "src/deploy/app.yaml", expected: false},
+		{pattern: "deploy/", path: "deployment.md", expected: false},
+		{pattern: "deploy/", path: "deploy", expected: false},
+		{pattern: "services/*/api/**", path: "services/billing/api/v1/invoice.proto", expected: true},
+		{pattern: "services/*/api/**", path: "services/billing/internal/api.go", expected: false},
+		{pattern: "apps/*/", path: "apps/web/index.ts", expected: true},
+		{pattern: "apps/*/", path: "apps/README.md", expected: false},
+		{pattern: "apps/*/", path: "libs/apps/web/index.ts", expected: false},
+		{pattern: ".github/workflows/*.yml", path: ".github/workflows/ci.yml", expected: true},
+		{pattern: ".github/workflows/*.yml", path: ".github/workflows/nested/ci.yml", expected: false},
+		{pattern: "*.tf", path: "infra/main.tf", expected: true},
+		{pattern: "*.tf", path: "infra/main.tfvars", expected: false},
+		{pattern: "go.mod", path: "go.mod", expected: true},
+		{pattern: "go.mod", path: "tools/go.mod", expected: true},
+		{pattern: "**/testdata/", path: "testdata/msg.txt", expected: true},
+		{pattern: "**/testdata/", path: "internal/model/testdata/msg.txt", expected: true},
+		{pattern: "**/testdata/", path: "internal/testdata.go", expected: false},
+		{pattern: "**/api/*.proto", path: "services/billing/api/invoice.proto", expected: true},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.pattern+" "+tabletest.path, func(t *testing.T) {
+			require.Equal(t, tabletest.expected, MatchPath(tabletest.pattern, tabletest.path))
+		})
+	}
+}
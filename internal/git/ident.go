@@ -0,0 +1,111 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+package git
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+
+	"github.com/go-git/go-git/v5/config"
+	"github.com/itiquette/gommitlint/internal/model"
+)
+
+// ErrNoIdentity is returned when no identity is configured.
+var ErrNoIdentity = errors.New("no git identity configured")
+
+// AuthorIdent returns the identity git would record as author of a new commit.
+// It asks 'git var GIT_AUTHOR_IDENT', which honours GIT_AUTHOR_NAME/EMAIL and all
+// config scopes, and falls back to the repository configuration when git is unavailable.
+func AuthorIdent(repo *model.Repository) (model.Identity, error) {
+	return resolveIdent(repo, "GIT_AUTHOR_IDENT", func(cfg *config.Config) (string, string) {
+		return cfg.Author.Name, cfg.Author.Email
+	})
+}
+
+// CommitterIdent returns the identity git would record as committer of a new commit.
+func CommitterIdent(repo *model.Repository) (model.Identity, error) {
+	return resolveIdent(repo, "GIT_COMMITTER_IDENT", func(cfg *config.Config) (string, string) {
+		return cfg.Committer.Name, cfg.Committer.Email
+	})
+}
+
+// resolveIdent runs 'git var' for the variable and falls back to the repository configuration.
+// The role function returns the role specific (author.* or committer.*) settings, each of
+// which takes precedence over the matching user.* setting.
+func resolveIdent(repo *model.Repository, variable string, role func(*config.Config) (string, string)) (model.Identity, error) {
+	cmd := exec.Command("git", "var", variable)
+	cmd.Dir = repoDir(repo)
+
+	if output, err := cmd.Output(); err == nil {
+		if ident, err := parseIdent(string(output)); err == nil {
+			return ident, nil
+		}
+	}
+
+	if repo == nil || repo.Repo == nil {
+		return model.Identity{}, ErrNoIdentity
+	}
+
+	cfg, err := repo.Repo.ConfigScoped(config.GlobalScope)
+	if err != nil {
+		return model.Identity{}, fmt.Errorf("failed to read git config: %w", err)
+	}
+
+	ident := configIdent(cfg, role)
+	if ident.Name == "" && ident.Email == "" {
+		return model.Identity{}, ErrNoIdentity
+	}
+
+	return ident, nil
+}
+
+// configIdent merges the role specific settings with user.* field by field, as git does:
+// author.name without author.email still takes the email from user.email.
+func configIdent(cfg *config.Config, role func(*config.Config) (string, string)) model.Identity {
+	ident := model.Identity{Name: cfg.User.Name, Email: cfg.User.Email}
+
+	name, email := role(cfg)
+	if name != "" {
+		ident.Name = name
+	}
+
+	if email != "" {
+		ident.Email = email
+	}
+
+	return ident
+}
+
+// parseIdent parses the output of 'git var', "Name <email> timestamp timezone".
+func parseIdent(output string) (model.Identity, error) {
+	output = strings.TrimSpace(output)
+
+	start := strings.LastIndex(output, "<")
+	end := strings.LastIndex(output, ">")
+
+	if start < 0 || end < start {
+		return model.Identity{}, fmt.Errorf("invalid git identity %q", output)
+	}
+
+	return model.Identity{
+		Name:  strings.TrimSpace(output[:start]),
+		Email: strings.TrimSpace(output[start+1 : end]),
+	}, nil
+}
+
+// repoDir returns the working directory of the repository, or "" for the current directory.
+func repoDir(repo *model.Repository) string {
+	if repo == nil || repo.Repo == nil {
+		return ""
+	}
+
+	worktree, err := repo.Repo.Worktree()
+	if err != nil {
+		return ""
+	}
+
+	return worktree.Filesystem.Root()
+}
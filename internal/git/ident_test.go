@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package git
+
+import (
+	"os/exec"
+	"testing"
+
+	"github.com/go-git/go-git/v5/config"
+	"github.com/itiquette/gommitlint/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIdent(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  string
+		want    model.Identity
+		wantErr bool
+	}{
+		{
+			name:   "git var output",
+			output: "Jane Doe <jane@example.com> 1700000000 +0100\n",
+			want:   model.Identity{Name: "Jane Doe", Email: "jane@example.com"},
+		},
+		{
+			name:   "empty email",
+			output: "Jane Doe <> 1700000000 +0100",
+			want:   model.Identity{Name: "Jane Doe"},
+		},
+		{
+			name:    "no email brackets",
+			output:  "Jane Doe",
+			wantErr: true,
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			ident, err := parseIdent(tabletest.output)
+			if tabletest.wantErr {
+				require.Error(t, err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, tabletest.want, ident)
+		})
+	}
+}
+
+func TestConfigIdent(t *testing.T) {
+	author := func(cfg *config.Config) (string, string) {
+		return cfg.Author.Name, cfg.Author.Email
+	}
+
+	tests := []struct {
+		name   string
+		user   [2]string
+		author [2]string
+		want   model.Identity
+	}{
+		{
+			name: "user only",
+			user: [2]string{"Jane Doe", "jane@example.com"},
+			want: model.Identity{Name: "Jane Doe", Email: "jane@example.com"},
+		},
+		{
+			name:   "author overrides user",
+			user:   [2]string{"Jane Doe", "jane@example.com"},
+			author: [2]string{"Jane Work", "jane@work.example.com"},
+			want:   model.Identity{Name: "Jane Work", Email: "jane@work.example.com"},
+		},
+		{
+			name:   "author name with user email",
+			user:   [2]string{"", "jane@example.com"},
+			author: [2]string{"Jane Doe", ""},
+			want:   model.Identity{Name: "Jane Doe", Email: "jane@example.com"},
+		},
+		{
+			name:   "author email with user name",
+			user:   [2]string{"Jane Doe", ""},
+			author: [2]string{"", "jane@example.com"},
+			want:   model.Identity{Name: "Jane Doe", Email: "jane@example.com"},
+		},
+		{
+			name: "nothing configured",
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			cfg := config.NewConfig()
+			cfg.User.Name, cfg.User.Email = tabletest.user[0], tabletest.user[1]
+			cfg.Author.Name, cfg.Author.Email = tabletest.author[0], tabletest.author[1]
+
+			require.Equal(t, tabletest.want, configIdent(cfg, author))
+		})
+	}
+}
+
+func TestAuthorIdent(t *testing.T) {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git executable not available")
+	}
+
+	repo := setupTestRepo(t, t.TempDir())
+
+	t.Setenv("GIT_AUTHOR_NAME", "Jane Doe")
+	t.Setenv("GIT_AUTHOR_EMAIL", "jane@example.com")
+	t.Setenv("GIT_COMMITTER_NAME", "John Smith")
+	t.Setenv("GIT_COMMITTER_EMAIL", "john@example.com")
+
+	author, err := AuthorIdent(repo)
+	require.NoError(t, err)
+	require.Equal(t, model.Identity{Name: "Jane Doe", Email: "jane@example.com"}, author)
+
+	committer, err := CommitterIdent(repo)
+	require.NoError(t, err)
+	require.Equal(t, model.Identity{Name: "John Smith", Email: "john@example.com"}, committer)
+}
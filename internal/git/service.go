@@ -17,6 +17,9 @@ type Service interface {
 
 	// RefExists checks if a Git reference exists
 	RefExists(reference string) bool
+
+	// CurrentBranch returns the name of the checked out branch, or "" if HEAD is detached
+	CurrentBranch() (string, error)
 }
 
 // NewService creates a new Git service for the current directory.
@@ -47,24 +50,44 @@ func (s *defaultService) DetectMainBranch() (string, error) {
 		return "", nil //nolint
 	}
 
-	// First check if 'main' branch exists
-	mainBranchRef := plumbing.NewBranchReferenceName(defaultMainBranch)
+	if branch, ok := FindMainBranch(repo); ok {
+		return branch, nil
+	}
 
-	_, err = repo.Reference(mainBranchRef, true)
-	if err == nil {
-		return defaultMainBranch, nil
+	// If neither 'main' nor 'master' exist, return warning with error message
+	return defaultMainBranch, fmt.Errorf("neither 'main' nor 'master' branch found, using '%s' as fallback", defaultMainBranch)
+}
+
+// FindMainBranch returns the main branch of the repository, 'main' or else 'master',
+// and false if neither exists.
+func FindMainBranch(repo *git.Repository) (string, bool) {
+	for _, branch := range []string{defaultMainBranch, defaultMasterBranch} {
+		if _, err := repo.Reference(plumbing.NewBranchReferenceName(branch), true); err == nil {
+			return branch, true
+		}
 	}
 
-	// If 'main' doesn't exist, check if 'master' branch exists
-	masterBranchRef := plumbing.NewBranchReferenceName(defaultMasterBranch)
+	return "", false
+}
 
-	_, err = repo.Reference(masterBranchRef, true)
-	if err == nil {
-		return defaultMasterBranch, nil
+// CurrentBranch returns the short name of the branch HEAD points to.
+// An empty name without error is returned for a detached HEAD.
+func (s *defaultService) CurrentBranch() (string, error) {
+	repo, err := git.PlainOpen(s.repoPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to open repository: %w", err)
 	}
 
-	// If neither 'main' nor 'master' exist, return warning with error message
-	return defaultMainBranch, fmt.Errorf("neither 'main' nor 'master' branch found, using '%s' as fallback", defaultMainBranch)
+	head, err := repo.Reference(plumbing.HEAD, false)
+	if err != nil {
+		return "", fmt.Errorf("failed to read HEAD: %w", err)
+	}
+
+	if head.Type() != plumbing.SymbolicReference || !head.Target().IsBranch() {
+		return "", nil
+	}
+
+	return head.Target().Short(), nil
 }
 
 func (s *defaultService) RefExists(reference string) bool {
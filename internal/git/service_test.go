@@ -103,6 +103,29 @@ func TestGitService(t *testing.T) {
 		}
 	})
 
+	t.Run("CurrentBranch", func(t *testing.T) {
+		repoPath := filepath.Join(tmpDir, "current-branch-repo")
+		repo := setupRepo(t, repoPath, "release/1.x")
+
+		service, err := NewServiceForPath(repoPath)
+		require.NoError(t, err)
+
+		branch, err := service.CurrentBranch()
+		require.NoError(t, err)
+		require.Equal(t, "release/1.x", branch)
+
+		// Detach HEAD
+		headRef, err := repo.Head()
+		require.NoError(t, err)
+
+		err = repo.Storer.SetReference(plumbing.NewHashReference(plumbing.HEAD, headRef.Hash()))
+		require.NoError(t, err)
+
+		branch, err = service.CurrentBranch()
+		require.NoError(t, err)
+		require.Empty(t, branch)
+	})
+
 	t.Run("RefExists", func(t *testing.T) {
 		// Setup a repo with main branch and a commit
 		repoPath := filepath.Join(tmpDir, "ref-exists-repo")
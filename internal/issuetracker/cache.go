@@ -0,0 +1,140 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package issuetracker
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+// DefaultCacheTTL is how long a looked up issue is used without querying the tracker again.
+const DefaultCacheTTL = time.Hour
+
+// DefaultCachePath returns the default cache file in the user cache directory,
+// or "" if there is none.
+func DefaultCachePath() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(dir, "gommitlint", "issues.json")
+}
+
+// cacheEntry is a cached issue with the time it was looked up.
+type cacheEntry struct {
+	Issue
+	Checked time.Time `json:"checked"`
+}
+
+// Cache stores looked up issues in a JSON file.
+// A Cache with an empty path keeps the issues in memory only.
+type Cache struct {
+	path    string
+	ttl     time.Duration
+	now     func() time.Time
+	mutex   sync.Mutex
+	entries map[string]cacheEntry
+}
+
+// NewCache creates a cache stored at path, whose entries expire after ttl.
+func NewCache(path string, ttl time.Duration) *Cache {
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+
+	return &Cache{path: path, ttl: ttl, now: time.Now}
+}
+
+// get returns the cached issue for key and whether it has not yet expired.
+func (c *Cache) get(key string) (Issue, bool, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.load()
+
+	entry, found := c.entries[key]
+	if !found {
+		return Issue{}, false, false
+	}
+
+	return entry.Issue, c.now().Sub(entry.Checked) < c.ttl, true
+}
+
+// put caches the issue for key and writes the cache file.
+func (c *Cache) put(key string, issue Issue) error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.load()
+	c.entries[key] = cacheEntry{Issue: issue, Checked: c.now()}
+
+	if c.path == "" {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(c.entries, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode issue cache: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(c.path), 0o755); err != nil {
+		return fmt.Errorf("failed to create issue cache directory: %w", err)
+	}
+
+	// Write a temporary file first, so that concurrent runs never read a partial cache
+	tmp, err := os.CreateTemp(filepath.Dir(c.path), ".issues-*.json")
+	if err != nil {
+		return fmt.Errorf("failed to write issue cache: %w", err)
+	}
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+
+		return fmt.Errorf("failed to write issue cache: %w", err)
+	}
+
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+
+		return fmt.Errorf("failed to write issue cache: %w", err)
+	}
+
+	if err := os.Rename(tmp.Name(), c.path); err != nil {
+		os.Remove(tmp.Name())
+
+		return fmt.Errorf("failed to write issue cache: %w", err)
+	}
+
+	return nil
+}
+
+// load reads the cache file on first use. A missing or corrupt file gives an empty cache.
+func (c *Cache) load() {
+	if c.entries != nil {
+		return
+	}
+
+	c.entries = make(map[string]cacheEntry)
+
+	if c.path == "" {
+		return
+	}
+
+	data, err := os.ReadFile(c.path)
+	if err != nil {
+		return
+	}
+
+	var entries map[string]cacheEntry
+	if err := json.Unmarshal(data, &entries); err == nil && entries != nil {
+		c.entries = entries
+	}
+}
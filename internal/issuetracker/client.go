@@ -0,0 +1,263 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package issuetracker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Kinds of issue tracker APIs.
+const (
+	KindJira   = "jira"
+	KindGitHub = "github"
+	KindGitLab = "gitlab"
+)
+
+// DefaultTimeout limits how long a lookup may take.
+const DefaultTimeout = 10 * time.Second
+
+// ErrUnreachable is wrapped by lookup errors caused by a tracker that cannot be reached,
+// e.g. when working offline. Other errors, such as a rejected token, are not wrapped.
+var ErrUnreachable = errors.New("issue tracker unreachable")
+
+// defaultURLs are the API locations of hosted trackers.
+var defaultURLs = map[string]string{
+	KindGitHub: "https://api.github.com",
+	KindGitLab: "https://gitlab.com",
+}
+
+// Reference identifies an issue of a tracker.
+type Reference struct {
+	Project      string // Project, e.g. "PROJ" or "owner/repo"; empty for the default project
+	ID           string // Issue number
+	MergeRequest bool   // Whether the reference is a GitLab merge request (!12) rather than an issue
+}
+
+// Issue is the state of an issue.
+type Issue struct {
+	Exists bool   `json:"exists"`
+	Status string `json:"status,omitempty"`
+}
+
+// Client looks up issues with the REST API of an issue tracker.
+type Client struct {
+	kind       string
+	baseURL    string
+	token      string
+	project    string
+	httpClient *http.Client
+	cache      *Cache
+
+	// unreachable is the first transport error; no requests are made after it
+	unreachable error
+}
+
+// Option configures a Client.
+type Option func(*Client)
+
+// WithToken sets the API token sent with each request.
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
+// WithDefaultProject sets the project of references without one, e.g. the "owner/repo"
+// of a GitHub "#12" reference.
+func WithDefaultProject(project string) Option {
+	return func(c *Client) {
+		c.project = project
+	}
+}
+
+// WithHTTPClient sets the HTTP client used for requests.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithCache sets the cache of looked up issues.
+func WithCache(cache *Cache) Option {
+	return func(c *Client) {
+		c.cache = cache
+	}
+}
+
+// CheckAPI returns an error if no client can be created for the API of the given kind
+// at baseURL, e.g. for an unsupported kind or a Jira API without URL.
+func CheckAPI(kind, baseURL string) error {
+	if kind != KindJira && kind != KindGitHub && kind != KindGitLab {
+		return fmt.Errorf("unsupported issue tracker API %q, expected jira, github or gitlab", kind)
+	}
+
+	if baseURL == "" && defaultURLs[kind] == "" {
+		return fmt.Errorf("the %s issue tracker API needs a URL", kind)
+	}
+
+	return nil
+}
+
+// NewClient creates a client for the API of the given kind at baseURL.
+// GitHub and GitLab default to their hosted APIs when baseURL is empty.
+func NewClient(kind, baseURL string, opts ...Option) (*Client, error) {
+	if err := CheckAPI(kind, baseURL); err != nil {
+		return nil, err
+	}
+
+	if baseURL == "" {
+		baseURL = defaultURLs[kind]
+	}
+
+	client := &Client{
+		kind:       kind,
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: &http.Client{Timeout: DefaultTimeout},
+		cache:      NewCache("", DefaultCacheTTL),
+	}
+
+	for _, opt := range opts {
+		opt(client)
+	}
+
+	return client, nil
+}
+
+// Lookup returns the state of the referenced issue. A fresh cached state is returned
+// without a request; if the tracker cannot be reached, an expired cached state is used
+// instead. After the tracker could not be reached once, no further requests are made,
+// so that working offline does not wait for a timeout per reference.
+func (c *Client) Lookup(ctx context.Context, ref Reference) (Issue, error) {
+	if ref.Project == "" {
+		ref.Project = c.project
+	}
+
+	requestURL, err := c.issueURL(ref)
+	if err != nil {
+		return Issue{}, err
+	}
+
+	cached, fresh, found := c.cache.get(requestURL)
+	if fresh {
+		return cached, nil
+	}
+
+	if c.unreachable != nil {
+		if found {
+			return cached, nil
+		}
+
+		return Issue{}, c.unreachable
+	}
+
+	issue, err := c.fetch(ctx, requestURL)
+	if err != nil {
+		if !errors.Is(err, ErrUnreachable) {
+			return Issue{}, err
+		}
+
+		c.unreachable = err
+
+		if found {
+			return cached, nil
+		}
+
+		return Issue{}, err
+	}
+
+	// A cache that cannot be written only costs another request next time
+	_ = c.cache.put(requestURL, issue)
+
+	return issue, nil
+}
+
+// issueURL returns the API URL of the referenced issue.
+func (c *Client) issueURL(ref Reference) (string, error) {
+	switch c.kind {
+	case KindJira:
+		if ref.Project == "" {
+			return "", errors.New("a Jira reference needs a project key")
+		}
+
+		return fmt.Sprintf("%s/rest/api/2/issue/%s-%s?fields=status", c.baseURL, url.PathEscape(ref.Project), url.PathEscape(ref.ID)), nil
+
+	case KindGitHub:
+		owner, repo, found := strings.Cut(ref.Project, "/")
+		if !found {
+			return "", fmt.Errorf("GitHub reference #%s needs an owner/repo project", ref.ID)
+		}
+
+		return fmt.Sprintf("%s/repos/%s/%s/issues/%s", c.baseURL, url.PathEscape(owner), url.PathEscape(repo), url.PathEscape(ref.ID)), nil
+
+	default:
+		if ref.Project == "" {
+			return "", fmt.Errorf("GitLab reference %s needs a group/project", ref.ID)
+		}
+
+		collection := "issues"
+		if ref.MergeRequest {
+			collection = "merge_requests"
+		}
+
+		return fmt.Sprintf("%s/api/v4/projects/%s/%s/%s", c.baseURL, url.PathEscape(ref.Project), collection, url.PathEscape(ref.ID)), nil
+	}
+}
+
+// fetch requests an issue and reads its status.
+func (c *Client) fetch(ctx context.Context, requestURL string) (Issue, error) {
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, requestURL, nil)
+	if err != nil {
+		return Issue{}, fmt.Errorf("failed to create issue request: %w", err)
+	}
+
+	request.Header.Set("Accept", "application/json")
+
+	if c.token != "" {
+		if c.kind == KindGitLab {
+			request.Header.Set("PRIVATE-TOKEN", c.token)
+		} else {
+			request.Header.Set("Authorization", "Bearer "+c.token)
+		}
+	}
+
+	response, err := c.httpClient.Do(request)
+	if err != nil {
+		return Issue{}, fmt.Errorf("%w: %w", ErrUnreachable, err)
+	}
+	defer response.Body.Close()
+
+	switch {
+	case response.StatusCode == http.StatusNotFound || response.StatusCode == http.StatusGone:
+		return Issue{Exists: false}, nil
+	case response.StatusCode != http.StatusOK:
+		return Issue{}, fmt.Errorf("issue tracker answered %s", response.Status)
+	}
+
+	var body struct {
+		State  string `json:"state"`
+		Fields struct {
+			Status struct {
+				Name string `json:"name"`
+			} `json:"status"`
+		} `json:"fields"`
+	}
+
+	if err := json.NewDecoder(response.Body).Decode(&body); err != nil {
+		return Issue{}, fmt.Errorf("failed to read issue tracker response: %w", err)
+	}
+
+	if c.kind == KindJira {
+		return Issue{Exists: true, Status: body.Fields.Status.Name}, nil
+	}
+
+	return Issue{Exists: true, Status: body.State}, nil
+}
@@ -0,0 +1,238 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package issuetracker
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// newTracker starts a tracker stand-in serving the given paths, and counts its requests.
+func newTracker(t *testing.T, responses map[string]string) (*httptest.Server, *int) {
+	t.Helper()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		requests++
+
+		body, found := responses[request.URL.EscapedPath()]
+		if !found {
+			http.NotFound(writer, request)
+
+			return
+		}
+
+		writer.Header().Set("Content-Type", "application/json")
+		_, _ = writer.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	return server, &requests
+}
+
+func TestClientLookup(t *testing.T) {
+	server, _ := newTracker(t, map[string]string{
+		"/rest/api/2/issue/PROJ-1":                          `{"fields":{"status":{"name":"In Progress"}}}`,
+		"/repos/owner/repo/issues/12":                       `{"state":"open"}`,
+		"/repos/other/repo/issues/45":                       `{"state":"closed"}`,
+		"/api/v4/projects/group%2Fproject/issues/3":         `{"state":"opened"}`,
+		"/api/v4/projects/group%2Fproject/merge_requests/7": `{"state":"merged"}`,
+	})
+
+	tests := []struct {
+		name     string
+		kind     string
+		ref      Reference
+		expected Issue
+	}{
+		{
+			name:     "Jira issue",
+			kind:     KindJira,
+			ref:      Reference{Project: "PROJ", ID: "1"},
+			expected: Issue{Exists: true, Status: "In Progress"},
+		},
+		{
+			name:     "missing Jira issue",
+			kind:     KindJira,
+			ref:      Reference{Project: "PROJ", ID: "99999"},
+			expected: Issue{Exists: false},
+		},
+		{
+			name:     "GitHub issue of the default project",
+			kind:     KindGitHub,
+			ref:      Reference{ID: "12"},
+			expected: Issue{Exists: true, Status: "open"},
+		},
+		{
+			name:     "GitHub issue of another repository",
+			kind:     KindGitHub,
+			ref:      Reference{Project: "other/repo", ID: "45"},
+			expected: Issue{Exists: true, Status: "closed"},
+		},
+		{
+			name:     "GitLab issue",
+			kind:     KindGitLab,
+			ref:      Reference{Project: "group/project", ID: "3"},
+			expected: Issue{Exists: true, Status: "opened"},
+		},
+		{
+			name:     "GitLab merge request",
+			kind:     KindGitLab,
+			ref:      Reference{Project: "group/project", ID: "7", MergeRequest: true},
+			expected: Issue{Exists: true, Status: "merged"},
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			client, err := NewClient(tabletest.kind, server.URL, WithDefaultProject("owner/repo"))
+			require.NoError(t, err)
+
+			issue, err := client.Lookup(context.Background(), tabletest.ref)
+			require.NoError(t, err)
+			require.Equal(t, tabletest.expected, issue)
+		})
+	}
+}
+
+func TestClientLookupErrors(t *testing.T) {
+	_, err := NewClient("linear", "https://api.linear.app")
+	require.ErrorContains(t, err, "unsupported")
+
+	_, err = NewClient(KindJira, "")
+	require.ErrorContains(t, err, "needs a URL")
+
+	client, err := NewClient(KindGitHub, "")
+	require.NoError(t, err)
+
+	_, err = client.Lookup(context.Background(), Reference{ID: "12"})
+	require.ErrorContains(t, err, "owner/repo")
+	require.NotErrorIs(t, err, ErrUnreachable)
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, _ *http.Request) {
+		writer.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client, err = NewClient(KindJira, server.URL)
+	require.NoError(t, err)
+
+	_, err = client.Lookup(context.Background(), Reference{Project: "PROJ", ID: "1"})
+	require.ErrorContains(t, err, "401")
+	require.NotErrorIs(t, err, ErrUnreachable)
+}
+
+func TestClientUnreachable(t *testing.T) {
+	server, requests := newTracker(t, nil)
+	server.Close()
+
+	client, err := NewClient(KindGitHub, server.URL, WithCache(NewCache("", time.Hour)))
+	require.NoError(t, err)
+
+	_, err = client.Lookup(context.Background(), Reference{Project: "owner/repo", ID: "1"})
+	require.ErrorIs(t, err, ErrUnreachable)
+
+	// After the first transport failure no further requests are made
+	transport := &countingTransport{}
+	client.httpClient.Transport = transport
+
+	_, err = client.Lookup(context.Background(), Reference{Project: "owner/repo", ID: "2"})
+	require.ErrorIs(t, err, ErrUnreachable)
+	require.Zero(t, transport.requests)
+	require.Zero(t, *requests)
+}
+
+// countingTransport counts round trips without making them.
+type countingTransport struct {
+	requests int
+}
+
+func (c *countingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	c.requests++
+
+	return nil, errors.New("unexpected request")
+}
+
+func TestClientToken(t *testing.T) {
+	var headers http.Header
+
+	server := httptest.NewServer(http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
+		headers = request.Header
+		_, _ = writer.Write([]byte(`{"state":"opened"}`))
+	}))
+	defer server.Close()
+
+	client, err := NewClient(KindGitHub, server.URL, WithToken("secret"))
+	require.NoError(t, err)
+
+	_, err = client.Lookup(context.Background(), Reference{Project: "owner/repo", ID: "1"})
+	require.NoError(t, err)
+	require.Equal(t, "Bearer secret", headers.Get("Authorization"))
+
+	client, err = NewClient(KindGitLab, server.URL, WithToken("secret"))
+	require.NoError(t, err)
+
+	_, err = client.Lookup(context.Background(), Reference{Project: "group/project", ID: "1"})
+	require.NoError(t, err)
+	require.Equal(t, "secret", headers.Get("PRIVATE-TOKEN"))
+}
+
+func TestClientCache(t *testing.T) {
+	server, requests := newTracker(t, map[string]string{
+		"/repos/owner/repo/issues/12": `{"state":"open"}`,
+	})
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	path := filepath.Join(t.TempDir(), "cache", "issues.json")
+	ref := Reference{Project: "owner/repo", ID: "12"}
+
+	newClient := func() *Client {
+		cache := NewCache(path, time.Hour)
+		cache.now = func() time.Time { return now }
+
+		client, err := NewClient(KindGitHub, server.URL, WithCache(cache))
+		require.NoError(t, err)
+
+		return client
+	}
+
+	issue, err := newClient().Lookup(context.Background(), ref)
+	require.NoError(t, err)
+	require.Equal(t, Issue{Exists: true, Status: "open"}, issue)
+	require.Equal(t, 1, *requests)
+
+	// A new run reads the cache file instead of querying the tracker
+	issue, err = newClient().Lookup(context.Background(), ref)
+	require.NoError(t, err)
+	require.Equal(t, Issue{Exists: true, Status: "open"}, issue)
+	require.Equal(t, 1, *requests)
+
+	// An expired entry is looked up again
+	now = now.Add(2 * time.Hour)
+
+	_, err = newClient().Lookup(context.Background(), ref)
+	require.NoError(t, err)
+	require.Equal(t, 2, *requests)
+
+	// Offline, an expired entry is used instead
+	now = now.Add(2 * time.Hour)
+
+	server.Close()
+
+	issue, err = newClient().Lookup(context.Background(), ref)
+	require.NoError(t, err)
+	require.Equal(t, Issue{Exists: true, Status: "open"}, issue)
+
+	// Offline without a cache entry, the lookup fails
+	_, err = newClient().Lookup(context.Background(), Reference{Project: "owner/repo", ID: "13"})
+	require.ErrorIs(t, err, ErrUnreachable)
+}
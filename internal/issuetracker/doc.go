@@ -0,0 +1,35 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+/*
+Package issuetracker looks up issues with the REST API of an issue tracker.
+
+It is used to verify that the issues a commit message references exist and have an
+allowed status. The supported APIs are:
+
+  - jira: GET <url>/rest/api/2/issue/<PROJECT-ID>, reporting the status name
+  - github: GET <url>/repos/<owner>/<repo>/issues/<id>, reporting open or closed
+  - gitlab: GET <url>/api/v4/projects/<group/project>/issues/<id> (or merge_requests),
+    reporting opened, closed or merged
+
+Results are cached on disk, so that validating the same references again does not
+query the tracker. When the tracker cannot be reached, an expired cache entry is used
+if there is one, the error wraps ErrUnreachable, and the client makes no further
+requests. Other errors, such as a rejected token, do not wrap ErrUnreachable.
+
+Usage Example
+
+	cache := issuetracker.NewCache(issuetracker.DefaultCachePath(), time.Hour)
+
+	client, err := issuetracker.NewClient("github", "",
+		issuetracker.WithToken(os.Getenv("GITHUB_TOKEN")),
+		issuetracker.WithDefaultProject("itiquette/gommitlint"),
+		issuetracker.WithCache(cache))
+	if err != nil {
+		return err
+	}
+
+	issue, err := client.Lookup(ctx, issuetracker.Reference{ID: "12"})
+*/
+package issuetracker
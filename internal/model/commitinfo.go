@@ -14,4 +14,28 @@ type CommitInfo struct {
 	Signature     string         // Signature
 	RawCommit     *object.Commit // Gives access to the full commit object
 	IsMergeCommit bool           // Whether this is a merge commit
+
+	// Conventional is the message parsed as a conventional commit, nil if the subject is not one
+	Conventional *ConventionalCommit
+}
+
+// NewCommitInfo creates the CommitInfo for a message and, unless the message was read
+// from a file, the commit it belongs to.
+func NewCommitInfo(message string, commit *object.Commit) CommitInfo {
+	subject, body := SplitCommitMessage(message)
+
+	info := CommitInfo{
+		Message:      message,
+		Subject:      subject,
+		Body:         body,
+		RawCommit:    commit,
+		Conventional: ParseConventionalCommit(message),
+	}
+
+	if commit != nil {
+		info.Signature = commit.PGPSignature
+		info.IsMergeCommit = IsMergeCommit(commit)
+	}
+
+	return info
 }
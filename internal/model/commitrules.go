@@ -39,3 +39,22 @@ func (r *CommitRules) All() []CommitRule {
 func (r *CommitRules) Add(c CommitRule) {
 	r.rules = append(r.rules, c)
 }
+
+// Failed reports whether a rule has errors other than warnings and infos.
+// Rules that only report warnings, such as an unreachable issue tracker, pass.
+func Failed(rule CommitRule) bool {
+	for _, err := range rule.Errors() {
+		if err.Severity != SeverityWarning && err.Severity != SeverityInfo {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Redactor is implemented by rules that find values, such as secrets, that must
+// not be printed. Reports pass all output through Redact.
+type Redactor interface {
+	// Redact returns text with the values found by the rule hidden.
+	Redact(text string) string
+}
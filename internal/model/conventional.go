@@ -0,0 +1,192 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package model
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ConventionalSubjectRegex matches a conventional commit subject: type(scope)!: description.
+var ConventionalSubjectRegex = regexp.MustCompile(`^(\w+)(?:\(([\w,/-]+)\))?(!)?:[ ](.+)$`)
+
+// footerRegex matches the first line of a footer, "Token: value" or "Token #value".
+// Tokens use "-" instead of whitespace, except for "BREAKING CHANGE".
+var footerRegex = regexp.MustCompile(`^([A-Za-z0-9][\w-]*|BREAKING CHANGE)(: | #)(.*)$`)
+
+// ConventionalFooter is a footer of a conventional commit, e.g. "Refs: #123" or "BREAKING CHANGE: ...".
+type ConventionalFooter struct {
+	Token     string // Token as written, e.g. "Reviewed-by"
+	Separator string // ": " or " #"
+	Value     string // Value, continuation lines joined with newlines
+	Line      int    // 1-based line number in the message
+}
+
+// IsBreaking reports whether the footer declares a breaking change.
+func (f ConventionalFooter) IsBreaking() bool {
+	return f.Token == "BREAKING CHANGE" || f.Token == "BREAKING-CHANGE"
+}
+
+// ConventionalCommit is a commit message parsed according to the Conventional Commits specification.
+type ConventionalCommit struct {
+	Type                  string               // Commit type, e.g. "feat"
+	Scopes                []string             // Scopes, split at commas
+	BreakingMarker        bool                 // Whether the subject has the "!" marker
+	Breaking              bool                 // Whether the commit declares a breaking change by marker or footer
+	Description           string               // Description after "type(scope): "
+	Body                  string               // Free-form body, which may have several paragraphs
+	Footers               []ConventionalFooter // Footers in the final paragraph
+	BlankLineAfterSubject bool                 // Whether the subject is followed by a blank line (or nothing)
+}
+
+// Scope returns the scopes as written in the subject, e.g. "ui,api".
+func (c *ConventionalCommit) Scope() string {
+	return strings.Join(c.Scopes, ",")
+}
+
+// BreakingDescription returns the value of the first BREAKING CHANGE footer.
+func (c *ConventionalCommit) BreakingDescription() (string, bool) {
+	for _, footer := range c.Footers {
+		if footer.IsBreaking() {
+			return footer.Value, true
+		}
+	}
+
+	return "", false
+}
+
+// FooterValues returns the values of all footers with the token, compared case-insensitively.
+func (c *ConventionalCommit) FooterValues(token string) []string {
+	var values []string
+
+	for _, footer := range c.Footers {
+		if strings.EqualFold(footer.Token, token) {
+			values = append(values, footer.Value)
+		}
+	}
+
+	return values
+}
+
+// ParseConventionalCommit parses a commit message according to the Conventional Commits
+// specification. It returns nil if the subject is not a conventional commit subject.
+//
+// The body starts after the blank line following the subject and may have several
+// paragraphs. The footers are the final paragraph if its first line is a footer; lines
+// that do not start a new footer continue the value of the previous one. Comment lines and
+// everything after the "git commit --verbose" scissors line are ignored.
+func ParseConventionalCommit(message string) *ConventionalCommit {
+	lines := messageLines(message)
+	if len(lines) == 0 {
+		return nil
+	}
+
+	matches := ConventionalSubjectRegex.FindStringSubmatch(lines[0].text)
+	if matches == nil {
+		return nil
+	}
+
+	commit := &ConventionalCommit{
+		Type:                  matches[1],
+		BreakingMarker:        matches[3] == "!",
+		Description:           matches[4],
+		BlankLineAfterSubject: len(lines) == 1 || strings.TrimSpace(lines[1].text) == "",
+	}
+
+	if matches[2] != "" {
+		commit.Scopes = strings.Split(matches[2], ",")
+	}
+
+	rest := lines[1:]
+
+	// Trim blank lines at the end
+	for len(rest) > 0 && strings.TrimSpace(rest[len(rest)-1].text) == "" {
+		rest = rest[:len(rest)-1]
+	}
+
+	footerStart := len(rest)
+
+	for index := len(rest) - 1; index >= 0; index-- {
+		if strings.TrimSpace(rest[index].text) == "" {
+			break
+		}
+
+		if footerRegex.MatchString(rest[index].text) {
+			footerStart = index
+		}
+	}
+
+	// The footers start at the first footer line of the final paragraph
+	if footerStart < len(rest) && (footerStart == 0 || strings.TrimSpace(rest[footerStart-1].text) == "") {
+		commit.Footers = parseFooters(rest[footerStart:])
+	} else {
+		footerStart = len(rest)
+	}
+
+	body := make([]string, 0, footerStart)
+	for _, line := range rest[:footerStart] {
+		body = append(body, line.text)
+	}
+
+	commit.Body = strings.Trim(strings.Join(body, "\n"), "\n")
+	_, hasBreakingFooter := commit.BreakingDescription()
+	commit.Breaking = commit.BreakingMarker || hasBreakingFooter
+
+	return commit
+}
+
+// parseFooters parses the footer lines. Lines that do not start a footer continue the previous one.
+func parseFooters(lines []messageLine) []ConventionalFooter {
+	var footers []ConventionalFooter
+
+	for _, line := range lines {
+		if match := footerRegex.FindStringSubmatch(line.text); match != nil {
+			footers = append(footers, ConventionalFooter{
+				Token:     match[1],
+				Separator: match[2],
+				Value:     strings.TrimSpace(match[3]),
+				Line:      line.number,
+			})
+
+			continue
+		}
+
+		if len(footers) > 0 {
+			last := &footers[len(footers)-1]
+			last.Value = strings.TrimSpace(last.Value + "\n" + strings.TrimSpace(line.text))
+		}
+	}
+
+	return footers
+}
+
+// messageLine is a line of a commit message with its 1-based line number.
+type messageLine struct {
+	text   string
+	number int
+}
+
+// messageLines splits a message into lines, dropping comment lines and everything after
+// the scissors line, and keeping the original line numbers.
+func messageLines(message string) []messageLine {
+	rawLines := strings.Split(message, "\n")
+	lines := make([]messageLine, 0, len(rawLines))
+
+	for index, text := range rawLines {
+		text = strings.TrimRight(text, "\r")
+
+		if text == scissorsLine {
+			break
+		}
+
+		if strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		lines = append(lines, messageLine{text: text, number: index + 1})
+	}
+
+	return lines
+}
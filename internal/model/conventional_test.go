@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package model_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseConventionalCommit(t *testing.T) {
+	tests := []struct {
+		name    string
+		message string
+		want    *model.ConventionalCommit
+	}{
+		{
+			name:    "not conventional",
+			message: "Add login\n\nBody",
+		},
+		{
+			name:    "subject only",
+			message: "feat: add login\n",
+			want: &model.ConventionalCommit{
+				Type:                  "feat",
+				Description:           "add login",
+				BlankLineAfterSubject: true,
+			},
+		},
+		{
+			name:    "scopes and marker",
+			message: "feat(ui,api)!: drop v1",
+			want: &model.ConventionalCommit{
+				Type:                  "feat",
+				Scopes:                []string{"ui", "api"},
+				BreakingMarker:        true,
+				Breaking:              true,
+				Description:           "drop v1",
+				BlankLineAfterSubject: true,
+			},
+		},
+		{
+			name: "multi-paragraph body and footers",
+			message: "fix: prevent racing of requests\n\n" +
+				"Introduce a request id and a reference to latest request.\n\n" +
+				"Remove timeouts which were used to mitigate the racing issue.\n\n" +
+				"Reviewed-by: Z\n" +
+				"Refs #123\n",
+			want: &model.ConventionalCommit{
+				Type:        "fix",
+				Description: "prevent racing of requests",
+				Body: "Introduce a request id and a reference to latest request.\n\n" +
+					"Remove timeouts which were used to mitigate the racing issue.",
+				Footers: []model.ConventionalFooter{
+					{Token: "Reviewed-by", Separator: ": ", Value: "Z", Line: 7},
+					{Token: "Refs", Separator: " #", Value: "123", Line: 8},
+				},
+				BlankLineAfterSubject: true,
+			},
+		},
+		{
+			name: "breaking change footer with continuation",
+			message: "feat: allow config to extend other configs\n\n" +
+				"BREAKING CHANGE: `extends` key in config file is now used\n" +
+				"  for extending other config files",
+			want: &model.ConventionalCommit{
+				Type:        "feat",
+				Description: "allow config to extend other configs",
+				Breaking:    true,
+				Footers: []model.ConventionalFooter{{
+					Token:     "BREAKING CHANGE",
+					Separator: ": ",
+					Value:     "`extends` key in config file is now used\nfor extending other config files",
+					Line:      3,
+				}},
+				BlankLineAfterSubject: true,
+			},
+		},
+		{
+			name:    "footer-like line inside prose stays in body",
+			message: "docs: explain setup\n\nRun the installer first.\nNote: it needs root.",
+			want: &model.ConventionalCommit{
+				Type:                  "docs",
+				Description:           "explain setup",
+				Body:                  "Run the installer first.\nNote: it needs root.",
+				BlankLineAfterSubject: true,
+			},
+		},
+		{
+			name:    "missing blank line",
+			message: "feat: add login\nUsers can sign in.",
+			want: &model.ConventionalCommit{
+				Type:        "feat",
+				Description: "add login",
+				Body:        "Users can sign in.",
+			},
+		},
+		{
+			name:    "comments and scissors are ignored",
+			message: "feat: add login\n\n# Please enter the commit message\nBody\n# ------------------------ >8 ------------------------\nRefs: #1",
+			want: &model.ConventionalCommit{
+				Type:                  "feat",
+				Description:           "add login",
+				Body:                  "Body",
+				BlankLineAfterSubject: true,
+			},
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			require.Equal(t, tabletest.want, model.ParseConventionalCommit(tabletest.message))
+		})
+	}
+}
+
+func TestConventionalCommitAccessors(t *testing.T) {
+	commit := model.ParseConventionalCommit("feat(ui,api): add x\n\nrefs: #1\nRefs: #2\nBREAKING-CHANGE: y")
+	require.NotNil(t, commit)
+
+	require.Equal(t, "ui,api", commit.Scope())
+	require.Equal(t, []string{"#1", "#2"}, commit.FooterValues("Refs"))
+	require.True(t, commit.Breaking)
+
+	description, ok := commit.BreakingDescription()
+	require.True(t, ok)
+	require.Equal(t, "y", description)
+}
+
+func TestNewCommitInfo(t *testing.T) {
+	info := model.NewCommitInfo("feat: add login\n\nBody\n", nil)
+
+	require.Equal(t, "feat: add login", info.Subject)
+	require.Equal(t, "Body", info.Body)
+	require.NotNil(t, info.Conventional)
+	require.Equal(t, "feat", info.Conventional.Type)
+	require.Nil(t, info.RawCommit)
+
+	require.Nil(t, model.NewCommitInfo("Add login", nil).Conventional)
+}
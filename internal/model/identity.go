@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package model
+
+import "fmt"
+
+// Identity is a person identified by name and email, such as an author, a committer
+// or a sign-off.
+type Identity struct {
+	Name  string
+	Email string
+}
+
+// String formats the identity as "Name <email>".
+func (id Identity) String() string {
+	return fmt.Sprintf("%s <%s>", id.Name, id.Email)
+}
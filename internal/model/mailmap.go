@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package model
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// mailmapPartRegex matches an optional name followed by an email in angle brackets.
+var mailmapPartRegex = regexp.MustCompile(`\s*([^<]*?)\s*<([^<>]*)>`)
+
+// MailmapEntry is one mapping of a .mailmap file.
+type MailmapEntry struct {
+	ProperName  string // Canonical name, may be empty
+	ProperEmail string // Canonical email, may be empty
+	CommitName  string // Name as recorded in commits, empty matches any name
+	CommitEmail string // Email as recorded in commits, empty means the entry maps ProperEmail itself
+}
+
+// Mailmap maps the identities recorded in commits to canonical identities, see gitmailmap(5).
+type Mailmap struct {
+	Entries []MailmapEntry
+}
+
+// ParseMailmap parses the contents of a .mailmap file. Supported forms are:
+//
+//	Proper Name <commit@email>
+//	<proper@email> <commit@email>
+//	Proper Name <proper@email> <commit@email>
+//	Proper Name <proper@email> Commit Name <commit@email>
+//
+// Blank lines and comments starting with "#" are ignored.
+func ParseMailmap(content string) (*Mailmap, error) {
+	mailmap := &Mailmap{}
+
+	for index, line := range strings.Split(content, "\n") {
+		if hash := strings.Index(line, "#"); hash >= 0 {
+			line = line[:hash]
+		}
+
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		parts := mailmapPartRegex.FindAllStringSubmatch(line, -1)
+
+		switch len(parts) {
+		case 1:
+			mailmap.Entries = append(mailmap.Entries, MailmapEntry{
+				ProperName:  parts[0][1],
+				ProperEmail: parts[0][2],
+			})
+		case 2:
+			mailmap.Entries = append(mailmap.Entries, MailmapEntry{
+				ProperName:  parts[0][1],
+				ProperEmail: parts[0][2],
+				CommitName:  parts[1][1],
+				CommitEmail: parts[1][2],
+			})
+		default:
+			return nil, fmt.Errorf("invalid mailmap entry on line %d: %q", index+1, line)
+		}
+	}
+
+	return mailmap, nil
+}
+
+// LoadMailmap reads and parses a .mailmap file.
+func LoadMailmap(path string) (*Mailmap, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read mailmap: %w", err)
+	}
+
+	return ParseMailmap(string(content))
+}
+
+// Contains reports whether the email appears in the mailmap, either as canonical or as commit email.
+func (m *Mailmap) Contains(email string) bool {
+	if m == nil {
+		return false
+	}
+
+	for _, entry := range m.Entries {
+		if strings.EqualFold(entry.ProperEmail, email) || strings.EqualFold(entry.CommitEmail, email) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Resolve returns the canonical name and email for an identity recorded in a commit.
+// Like git, an entry with a commit name takes precedence over one matching the email only,
+// and emails are compared case-insensitively.
+func (m *Mailmap) Resolve(name, email string) (string, string) {
+	if m == nil {
+		return name, email
+	}
+
+	var match *MailmapEntry
+
+	for index := range m.Entries {
+		entry := &m.Entries[index]
+
+		commitEmail := entry.CommitEmail
+		if commitEmail == "" {
+			commitEmail = entry.ProperEmail
+		}
+
+		if !strings.EqualFold(commitEmail, email) {
+			continue
+		}
+
+		if entry.CommitName != "" {
+			if strings.EqualFold(entry.CommitName, name) {
+				match = entry
+
+				break
+			}
+
+			continue
+		}
+
+		if match == nil {
+			match = entry
+		}
+	}
+
+	if match == nil {
+		return name, email
+	}
+
+	if match.ProperName != "" {
+		name = match.ProperName
+	}
+
+	if match.ProperEmail != "" && match.CommitEmail != "" {
+		email = match.ProperEmail
+	}
+
+	return name, email
+}
@@ -0,0 +1,57 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package model_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMailmap(t *testing.T) {
+	content := `# Team identities
+Jane Doe <jane@example.com>
+<john@example.com> <john@old.example.com>
+Jane Doe <jane@example.com> <jane.doe@gmail.com>
+Bob Builder <bob@example.com> Bobby <bob@home.example.com>   # trailing comment
+`
+
+	mailmap, err := model.ParseMailmap(content)
+	require.NoError(t, err)
+	require.Len(t, mailmap.Entries, 4)
+
+	tests := []struct {
+		name      string
+		inName    string
+		inEmail   string
+		wantName  string
+		wantEmail string
+	}{
+		{"name only mapping", "jane", "JANE@example.com", "Jane Doe", "JANE@example.com"},
+		{"email mapping", "John", "john@old.example.com", "John", "john@example.com"},
+		{"name and email mapping", "J", "jane.doe@gmail.com", "Jane Doe", "jane@example.com"},
+		{"commit name must match", "Bobby", "bob@home.example.com", "Bob Builder", "bob@example.com"},
+		{"commit name mismatch", "Robert", "bob@home.example.com", "Robert", "bob@home.example.com"},
+		{"unknown identity", "Eve", "eve@example.com", "Eve", "eve@example.com"},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			name, email := mailmap.Resolve(tabletest.inName, tabletest.inEmail)
+			require.Equal(t, tabletest.wantName, name)
+			require.Equal(t, tabletest.wantEmail, email)
+		})
+	}
+
+	require.True(t, mailmap.Contains("jane.doe@gmail.com"))
+	require.True(t, mailmap.Contains("John@Example.com"))
+	require.False(t, mailmap.Contains("eve@example.com"))
+}
+
+func TestParseMailmapInvalid(t *testing.T) {
+	_, err := model.ParseMailmap("Jane Doe jane@example.com")
+	require.Error(t, err)
+}
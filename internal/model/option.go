@@ -8,6 +8,11 @@ type Options struct {
 	MsgFromFile    *string
 	RevisionRange  string
 	CommitRef      string
+	TagPattern     string // Glob of tag names to validate instead of commits
+	TargetBranch   string // Branch the commits are merged into, used by when: conditions
+	BaseBranch     string // Base branch given with --base-branch, empty when not validating against one
+	ValidateBranch bool   // Whether to validate the branch name instead of commits
+	Branch         string // Branch name to validate, empty for a detached HEAD
 	Verbose        bool   // Added for verbose output
 	ShowHelp       bool   // Added for detailed rule help
 	RuleToShowHelp string // Added to track which rule's help to show
@@ -20,6 +25,11 @@ func NewOptions() *Options {
 		MsgFromFile:    nil,
 		RevisionRange:  "",
 		CommitRef:      "",
+		TagPattern:     "",
+		TargetBranch:   "",
+		BaseBranch:     "",
+		ValidateBranch: false,
+		Branch:         "",
 		Verbose:        false,
 		ShowHelp:       false,
 		RuleToShowHelp: "",
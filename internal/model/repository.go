@@ -7,7 +7,9 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"path"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/go-git/go-git/v5"
@@ -80,16 +82,9 @@ func (r *Repository) GetHeadCommit() (*CommitInfo, error) {
 		return nil, fmt.Errorf("failed to get commit object: %w", err)
 	}
 
-	subject, body := SplitCommitMessage(commit.Message)
+	commitInfo := NewCommitInfo(commit.Message, commit)
 
-	return &CommitInfo{
-		Message:       commit.Message,
-		Subject:       subject,
-		Body:          body,
-		Signature:     commit.PGPSignature,
-		RawCommit:     commit,
-		IsMergeCommit: IsMergeCommit(commit),
-	}, nil
+	return &commitInfo, nil
 }
 
 // CommitInfos retrieves commit information between two revisions.
@@ -114,6 +109,46 @@ func (r *Repository) CommitInfos(rev1, rev2 string) ([]CommitInfo, error) {
 	return r.getCommitRange(rev1, rev2)
 }
 
+// CommitExists reports whether the revision, e.g. a full or abbreviated hash, names a commit.
+func (r *Repository) CommitExists(revision string) bool {
+	hash, err := r.Repo.ResolveRevision(plumbing.Revision(revision))
+	if err != nil {
+		return false
+	}
+
+	_, err = r.Repo.CommitObject(*hash)
+
+	return err == nil
+}
+
+// IsAncestor reports whether the commit is reachable from revision, e.g. a commit of the base branch.
+// A commit is reachable from itself.
+func (r *Repository) IsAncestor(commit, revision string) bool {
+	commitHash, err := r.Repo.ResolveRevision(plumbing.Revision(commit))
+	if err != nil {
+		return false
+	}
+
+	revisionHash, err := r.Repo.ResolveRevision(plumbing.Revision(revision))
+	if err != nil {
+		return false
+	}
+
+	commitObject, err := r.Repo.CommitObject(*commitHash)
+	if err != nil {
+		return false
+	}
+
+	revisionObject, err := r.Repo.CommitObject(*revisionHash)
+	if err != nil {
+		return false
+	}
+
+	isAncestor, err := commitObject.IsAncestor(revisionObject)
+
+	return err == nil && isAncestor
+}
+
 // getCommitRange returns commits between rev1 and rev2 (exclusive of rev1).
 // Format is similar to git log rev1..rev2.
 func (r *Repository) getCommitRange(rev1, rev2 string) ([]CommitInfo, error) {
@@ -146,15 +181,7 @@ func (r *Repository) getCommitRange(rev1, rev2 string) ([]CommitInfo, error) {
 			return storer.ErrStop
 		}
 
-		subject, body := SplitCommitMessage(commitObject.Message)
-		commits = append(commits, CommitInfo{
-			Message:       commitObject.Message,
-			Subject:       subject,
-			Body:          body,
-			Signature:     commitObject.PGPSignature,
-			RawCommit:     commitObject,
-			IsMergeCommit: IsMergeCommit(commitObject),
-		})
+		commits = append(commits, NewCommitInfo(commitObject.Message, commitObject))
 
 		return nil
 	})
@@ -167,6 +194,71 @@ func (r *Repository) getCommitRange(rev1, rev2 string) ([]CommitInfo, error) {
 	return commits, nil
 }
 
+// TagInfos retrieves information about the tags whose names match pattern.
+// The pattern uses path.Match syntax (e.g. "v*"); an empty pattern matches all tags.
+// Annotated tags are loaded as tag objects, lightweight tags only carry their name and target.
+// Tags are returned sorted by name.
+func (r *Repository) TagInfos(pattern string) ([]TagInfo, error) {
+	if pattern == "" {
+		pattern = "*"
+	}
+
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("invalid tag pattern %q: %w", pattern, err)
+	}
+
+	refs, err := r.Repo.Tags()
+	if err != nil {
+		return nil, fmt.Errorf("failed to list tags: %w", err)
+	}
+	defer refs.Close()
+
+	tags := make([]TagInfo, 0, 16)
+	err = refs.ForEach(func(ref *plumbing.Reference) error {
+		name := ref.Name().Short()
+
+		if matched, _ := path.Match(pattern, name); !matched {
+			return nil
+		}
+
+		tagObject, err := r.Repo.TagObject(ref.Hash())
+
+		switch {
+		case errors.Is(err, plumbing.ErrObjectNotFound):
+			// Lightweight tag pointing directly at a commit
+			tags = append(tags, TagInfo{
+				Name:   name,
+				Target: ref.Hash(),
+			})
+		case err != nil:
+			return fmt.Errorf("failed to read tag %s: %w", name, err)
+		default:
+			subject, body := SplitCommitMessage(tagObject.Message)
+			tags = append(tags, TagInfo{
+				Name:        name,
+				Message:     tagObject.Message,
+				Subject:     subject,
+				Body:        body,
+				Signature:   tagObject.PGPSignature,
+				IsAnnotated: true,
+				Target:      tagObject.Target,
+				RawTag:      tagObject,
+			})
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error iterating tags: %w", err)
+	}
+
+	sort.Slice(tags, func(i, j int) bool {
+		return tags[i].Name < tags[j].Name
+	})
+
+	return tags, nil
+}
+
 // SplitCommitMessage separates a commit message into subject and body.
 // It returns the first line as subject and the rest (if any) as body.
 func SplitCommitMessage(message string) (string, string) {
@@ -155,6 +155,40 @@ func TestGetHeadCommit(t *testing.T) {
 	require.Equal(t, hash, headCommit.RawCommit.Hash)
 }
 
+func TestCommitExists(t *testing.T) {
+	tempDir, gitRepo := setupTestRepo(t)
+	defer cleanupTestRepo(t, tempDir)
+
+	hash := addCommit(t, gitRepo, "Initial commit")
+
+	repo, err := NewRepository(tempDir)
+	require.NoError(t, err)
+
+	require.True(t, repo.CommitExists(hash.String()))
+	require.True(t, repo.CommitExists(hash.String()[:7]))
+	require.False(t, repo.CommitExists("0123456789abcdef0123456789abcdef01234567"))
+	require.False(t, repo.CommitExists("not-a-revision"))
+}
+
+func TestIsAncestor(t *testing.T) {
+	tempDir, gitRepo := setupTestRepo(t)
+	defer cleanupTestRepo(t, tempDir)
+
+	first := addCommit(t, gitRepo, "Initial commit")
+
+	require.NoError(t, os.WriteFile(filepath.Join(tempDir, "test.txt"), []byte("changed content"), 0600))
+	second := addCommit(t, gitRepo, "Second commit")
+
+	repo, err := NewRepository(tempDir)
+	require.NoError(t, err)
+
+	require.True(t, repo.IsAncestor(first.String(), second.String()))
+	require.True(t, repo.IsAncestor(first.String()[:7], "HEAD"))
+	require.True(t, repo.IsAncestor(second.String(), second.String()))
+	require.False(t, repo.IsAncestor(second.String(), first.String()))
+	require.False(t, repo.IsAncestor(first.String(), "not-a-revision"))
+}
+
 func TestSplitCommitMessage(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -366,3 +400,56 @@ func TestFindGitDir(t *testing.T) {
 		})
 	}
 }
+
+func TestTagInfos(t *testing.T) {
+	tempDir, repo := setupTestRepo(t)
+	defer cleanupTestRepo(t, tempDir)
+
+	commitHash := addCommit(t, repo, "feat: initial commit")
+
+	tagger := &object.Signature{Name: "Test User", Email: "test@example.com"}
+
+	_, err := repo.CreateTag("v1.0.0", commitHash, &git.CreateTagOptions{
+		Tagger:  tagger,
+		Message: "Release 1.0.0\n\nFirst stable release.\n",
+	})
+	require.NoError(t, err)
+
+	_, err = repo.CreateTag("v0.9.0", commitHash, nil)
+	require.NoError(t, err)
+
+	_, err = repo.CreateTag("nightly", commitHash, nil)
+	require.NoError(t, err)
+
+	repository := &Repository{Repo: repo}
+
+	t.Run("pattern selects tags", func(t *testing.T) {
+		tags, err := repository.TagInfos("v*")
+		require.NoError(t, err)
+		require.Len(t, tags, 2)
+
+		// Sorted by name
+		require.Equal(t, "v0.9.0", tags[0].Name)
+		require.False(t, tags[0].IsAnnotated)
+		require.Nil(t, tags[0].RawTag)
+		require.Equal(t, commitHash, tags[0].Target)
+
+		require.Equal(t, "v1.0.0", tags[1].Name)
+		require.True(t, tags[1].IsAnnotated)
+		require.NotNil(t, tags[1].RawTag)
+		require.Equal(t, "Release 1.0.0", tags[1].Subject)
+		require.Equal(t, "First stable release.", tags[1].Body)
+		require.Equal(t, commitHash, tags[1].Target)
+	})
+
+	t.Run("empty pattern matches all tags", func(t *testing.T) {
+		tags, err := repository.TagInfos("")
+		require.NoError(t, err)
+		require.Len(t, tags, 3)
+	})
+
+	t.Run("invalid pattern", func(t *testing.T) {
+		_, err := repository.TagInfos("[")
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,22 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package model
+
+import (
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+// TagInfo holds information about a tag.
+type TagInfo struct {
+	Name        string        // Short tag name, e.g. v1.2.3
+	Message     string        // Full tag message, empty for lightweight tags
+	Subject     string        // First line of tag message
+	Body        string        // Rest of tag message after first line
+	Signature   string        // Signature, empty for lightweight and unsigned tags
+	IsAnnotated bool          // Whether the tag is an annotated tag object
+	Target      plumbing.Hash // Hash of the tagged object
+	RawTag      *object.Tag   // Gives access to the full tag object, nil for lightweight tags
+}
@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package model
+
+import (
+	"regexp"
+	"strings"
+)
+
+// trailerRegex matches a "Key: value" trailer line. As in git, the key consists of
+// alphanumeric characters and dashes, and whitespace is allowed around the separator.
+var trailerRegex = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9-]*)[ \t]*:[ \t]*(.*)$`)
+
+// gitGeneratedTrailerPrefixes are prefixes git itself writes. A paragraph containing one
+// of them is a trailer block even when up to 75% of its lines are not trailers.
+var gitGeneratedTrailerPrefixes = []string{"Signed-off-by: ", "(cherry picked from commit "}
+
+// scissorsLine marks the start of the diff that "git commit --verbose" appends.
+const scissorsLine = "# ------------------------ >8 ------------------------"
+
+// Trailer is a single "Key: value" line in a commit or tag message.
+type Trailer struct {
+	Key   string // Key as written, e.g. "Signed-off-by"
+	Value string // Value with continuation lines joined by a single space
+	Line  int    // 1-based line number in the message
+}
+
+// Trailers holds the trailers of a message, parsed the way "git interpret-trailers" does.
+type Trailers struct {
+	// Block lists the trailers of the trailer block, the final paragraph of the message.
+	Block []Trailer
+
+	// StartLine is the 1-based line number where the trailer block starts, 0 if there is none.
+	StartLine int
+
+	// Outside lists trailer-like lines found in the body before the trailer block.
+	Outside []Trailer
+}
+
+// ParseTrailers parses the trailer block of a message.
+//
+// Like git, the trailer block is the last paragraph of the message, never the subject.
+// A paragraph is a trailer block if all of its lines are trailers, or if it contains a
+// trailer generated by git (such as Signed-off-by) and at least 25% of its lines are
+// trailers. Lines starting with whitespace continue the previous trailer. Comment lines
+// and everything after the "git commit --verbose" scissors line are ignored.
+func ParseTrailers(message string) Trailers {
+	lines := strings.Split(message, "\n")
+
+	end := len(lines)
+
+	for index, line := range lines {
+		lines[index] = strings.TrimRight(line, "\r")
+
+		if lines[index] == scissorsLine {
+			end = index
+
+			break
+		}
+	}
+
+	lines = lines[:end]
+
+	// Find the last paragraph, skipping trailing blank and comment lines
+	last := len(lines) - 1
+	for last >= 0 && isBlankOrComment(lines[last]) {
+		last--
+	}
+
+	first := last
+	for first > 0 && strings.TrimSpace(lines[first-1]) != "" {
+		first--
+	}
+
+	var trailers Trailers
+
+	if first <= 0 {
+		return trailers
+	}
+
+	if block, ok := parseTrailerParagraph(lines[first:last+1], first); ok {
+		trailers.Block = block
+		trailers.StartLine = first + 1
+	} else {
+		first = last + 1
+	}
+
+	// Trailer-like lines in the body, after the subject paragraph
+	inSubject := true
+
+	for index := 1; index < first; index++ {
+		if strings.TrimSpace(lines[index]) == "" {
+			inSubject = false
+
+			continue
+		}
+
+		if inSubject || strings.HasPrefix(lines[index], "#") {
+			continue
+		}
+
+		if match := trailerRegex.FindStringSubmatch(lines[index]); match != nil {
+			trailers.Outside = append(trailers.Outside, Trailer{Key: match[1], Value: strings.TrimSpace(match[2]), Line: index + 1})
+		}
+	}
+
+	return trailers
+}
+
+// parseTrailerParagraph parses a paragraph starting at line offset, returning its trailers
+// and whether the paragraph is a trailer block.
+func parseTrailerParagraph(paragraph []string, offset int) ([]Trailer, bool) {
+	var (
+		block        []Trailer
+		trailerLines int
+		otherLines   int
+		recognized   bool
+		inTrailer    bool
+	)
+
+	for index, line := range paragraph {
+		if strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		for _, prefix := range gitGeneratedTrailerPrefixes {
+			if strings.HasPrefix(line, prefix) {
+				recognized = true
+			}
+		}
+
+		if inTrailer && (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) {
+			block[len(block)-1].Value += " " + strings.TrimSpace(line)
+
+			continue
+		}
+
+		match := trailerRegex.FindStringSubmatch(line)
+		if match == nil {
+			otherLines++
+			inTrailer = false
+
+			continue
+		}
+
+		trailerLines++
+		inTrailer = true
+
+		block = append(block, Trailer{Key: match[1], Value: strings.TrimSpace(match[2]), Line: offset + index + 1})
+	}
+
+	isBlock := trailerLines > 0 && (otherLines == 0 || (recognized && trailerLines*3 >= otherLines))
+
+	return block, isBlock
+}
+
+// isBlankOrComment reports whether a line is empty or a git comment line.
+func isBlankOrComment(line string) bool {
+	return strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#")
+}
+
+// Values returns the values of all trailers in the block with the given key.
+// Keys are compared case-insensitively.
+func (t Trailers) Values(key string) []string {
+	var values []string
+
+	for _, trailer := range t.Block {
+		if strings.EqualFold(trailer.Key, key) {
+			values = append(values, trailer.Value)
+		}
+	}
+
+	return values
+}
+
+// Has reports whether the trailer block contains a trailer with the given key.
+func (t Trailers) Has(key string) bool {
+	return len(t.Values(key)) > 0
+}
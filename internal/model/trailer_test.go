@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTrailers(t *testing.T) {
+	tests := []struct {
+		name        string
+		message     string
+		wantBlock   []Trailer
+		wantStart   int
+		wantOutside []Trailer
+	}{
+		{
+			name:    "Subject only",
+			message: "Fix parser",
+		},
+		{
+			name:    "Trailer-like subject is not a trailer block",
+			message: "Refs: PROJ-1",
+		},
+		{
+			name:    "Trailer directly below the subject",
+			message: "Fix parser\nSigned-off-by: Jane <jane@example.com>",
+		},
+		{
+			name:    "Trailer block",
+			message: "Fix parser\n\nHandle empty input.\n\nRefs: PROJ-1\nSigned-off-by: Jane <jane@example.com>\n",
+			wantBlock: []Trailer{
+				{Key: "Refs", Value: "PROJ-1", Line: 5},
+				{Key: "Signed-off-by", Value: "Jane <jane@example.com>", Line: 6},
+			},
+			wantStart: 5,
+		},
+		{
+			name:      "Whitespace around separator and continuation line",
+			message:   "Fix parser\n\nReviewed-by : Jane\n  <jane@example.com>",
+			wantBlock: []Trailer{{Key: "Reviewed-by", Value: "Jane <jane@example.com>", Line: 3}},
+			wantStart: 3,
+		},
+		{
+			name:        "Last paragraph with prose is not a trailer block",
+			message:     "Fix parser\n\nSee the notes below.\nRefs: PROJ-1",
+			wantOutside: []Trailer{{Key: "Refs", Value: "PROJ-1", Line: 4}},
+		},
+		{
+			name:    "Git generated trailer allows some prose",
+			message: "Fix parser\n\nSome prose here.\nSigned-off-by: Jane <jane@example.com>\nRefs: PROJ-1",
+			wantBlock: []Trailer{
+				{Key: "Signed-off-by", Value: "Jane <jane@example.com>", Line: 4},
+				{Key: "Refs", Value: "PROJ-1", Line: 5},
+			},
+			wantStart: 3,
+		},
+		{
+			name:    "Comments and scissors are ignored",
+			message: "Fix parser\n\nChange-Id: I123\n# Please enter the commit message\n\n" + scissorsLine + "\ndiff --git a/x b/x\n\nFoo: bar\n",
+			wantBlock: []Trailer{
+				{Key: "Change-Id", Value: "I123", Line: 3},
+			},
+			wantStart: 3,
+		},
+		{
+			name:        "Trailer-like line outside the block",
+			message:     "Fix parser\n\nSigned-off-by: Jane <jane@example.com>\nMore prose.\n\nRefs: PROJ-1",
+			wantBlock:   []Trailer{{Key: "Refs", Value: "PROJ-1", Line: 6}},
+			wantStart:   6,
+			wantOutside: []Trailer{{Key: "Signed-off-by", Value: "Jane <jane@example.com>", Line: 3}},
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			trailers := ParseTrailers(tabletest.message)
+
+			require.Equal(t, tabletest.wantBlock, trailers.Block)
+			require.Equal(t, tabletest.wantStart, trailers.StartLine)
+			require.Equal(t, tabletest.wantOutside, trailers.Outside)
+		})
+	}
+}
+
+func TestTrailersValues(t *testing.T) {
+	trailers := ParseTrailers("Fix\n\nReviewed-by: A\nreviewed-by: B\nRefs: PROJ-1")
+
+	require.Equal(t, []string{"A", "B"}, trailers.Values("Reviewed-By"))
+	require.True(t, trailers.Has("refs"))
+	require.False(t, trailers.Has("Change-Id"))
+}
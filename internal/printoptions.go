@@ -37,6 +37,34 @@ type ColorScheme struct {
 
 // PrintReport prints validation results.
 func PrintReport(rules []model.CommitRule, commitInfo *model.CommitInfo, opts *PrintOptions) error {
+	return printReport(rules, opts, func(colorScheme ColorScheme, redact func(string) string) {
+		if commitInfo != nil && commitInfo.RawCommit != nil {
+			printCommitHeader(commitInfo, colorScheme, redact)
+		}
+	})
+}
+
+// PrintTagReport prints validation results for a tag.
+func PrintTagReport(rules []model.CommitRule, tagInfo *model.TagInfo, opts *PrintOptions) error {
+	return printReport(rules, opts, func(colorScheme ColorScheme, _ func(string) string) {
+		if tagInfo != nil {
+			printTagHeader(tagInfo, colorScheme)
+		}
+	})
+}
+
+// PrintBranchReport prints validation results for a branch name.
+func PrintBranchReport(rules []model.CommitRule, branch string, opts *PrintOptions) error {
+	return printReport(rules, opts, func(colorScheme ColorScheme, _ func(string) string) {
+		printBranchHeader(branch, colorScheme)
+	})
+}
+
+// printReport prints validation results below the header written by printHeader.
+// Values found by model.Redactor rules are hidden in all output, including the header.
+func printReport(rules []model.CommitRule, opts *PrintOptions, printHeader func(ColorScheme, func(string) string)) error {
+	rules, redact := redactRules(rules)
+
 	// Default options if none provided
 	if opts == nil {
 		opts = &PrintOptions{
@@ -93,10 +121,8 @@ func PrintReport(rules []model.CommitRule, commitInfo *model.CommitInfo, opts *P
 		return nil
 	}
 
-	// Print header with commit information
-	if commitInfo != nil && commitInfo.RawCommit != nil {
-		printCommitHeader(commitInfo, colorScheme)
-	}
+	// Print header with commit or tag information
+	printHeader(colorScheme, redact)
 
 	// Sort rules alphabetically by name
 	sortedRules := make([]model.CommitRule, len(rules))
@@ -112,16 +138,24 @@ func PrintReport(rules []model.CommitRule, commitInfo *model.CommitInfo, opts *P
 	// Use Unicode symbols based on terminal capabilities
 	passSymbol := colorScheme.Success("PASS")
 	failSymbol := colorScheme.Error("FAIL")
+	warnSymbol := colorScheme.Warning("WARN")
 
 	if canHandleUnicode() {
 		passSymbol = colorScheme.Success("✓")
 		failSymbol = colorScheme.Error("✗")
+		warnSymbol = colorScheme.Warning("!")
 	}
 
 	for _, rule := range sortedRules {
 		ruleName := colorScheme.Bold(rule.Name())
 
-		if len(rule.Errors()) == 0 {
+		if len(rule.Errors()) > 0 && !model.Failed(rule) {
+			// Warning, which does not fail the validation
+			passedRules++
+
+			fmt.Printf("%s %s: %s\n", warnSymbol, ruleName, colorScheme.Warning(rule.Result()))
+			fmt.Printf("    %s\n", colorScheme.VerboseInfo(rule.VerboseResult()))
+		} else if len(rule.Errors()) == 0 {
 			// Success
 			passedRules++
 
@@ -186,8 +220,60 @@ func PrintReport(rules []model.CommitRule, commitInfo *model.CommitInfo, opts *P
 	return nil
 }
 
+// redactedRule is a rule whose output hides the values found by Redactor rules.
+type redactedRule struct {
+	model.CommitRule
+	redact func(string) string
+}
+
+// Result returns the redacted result.
+func (rule redactedRule) Result() string {
+	return rule.redact(rule.CommitRule.Result())
+}
+
+// VerboseResult returns the redacted verbose result.
+func (rule redactedRule) VerboseResult() string {
+	return rule.redact(rule.CommitRule.VerboseResult())
+}
+
+// Help returns the redacted help.
+func (rule redactedRule) Help() string {
+	return rule.redact(rule.CommitRule.Help())
+}
+
+// redactRules returns the rules with their output redacted by all model.Redactor rules,
+// and the combined redaction. The rules are returned unchanged if none is a Redactor.
+func redactRules(rules []model.CommitRule) ([]model.CommitRule, func(string) string) {
+	var redactors []model.Redactor
+
+	for _, rule := range rules {
+		if redactor, ok := rule.(model.Redactor); ok {
+			redactors = append(redactors, redactor)
+		}
+	}
+
+	redact := func(text string) string {
+		for _, redactor := range redactors {
+			text = redactor.Redact(text)
+		}
+
+		return text
+	}
+
+	if len(redactors) == 0 {
+		return rules, redact
+	}
+
+	redacted := make([]model.CommitRule, 0, len(rules))
+	for _, rule := range rules {
+		redacted = append(redacted, redactedRule{CommitRule: rule, redact: redact})
+	}
+
+	return redacted, redact
+}
+
 // printCommitHeader prints a header with commit SHA and message information.
-func printCommitHeader(commitInfo *model.CommitInfo, colourScheme ColorScheme) {
+func printCommitHeader(commitInfo *model.CommitInfo, colourScheme ColorScheme, redact func(string) string) {
 	if commitInfo == nil || commitInfo.RawCommit == nil {
 		return
 	}
@@ -203,13 +289,58 @@ func printCommitHeader(commitInfo *model.CommitInfo, colourScheme ColorScheme) {
 	fmt.Printf("%s %s\n", colourScheme.Header("COMMIT-SHA:"), colourScheme.Bold(shortSHA))
 
 	// Print subject line
-	fmt.Printf("%s %s\n", colourScheme.Header("SUBJECT:"), commitInfo.Subject)
+	fmt.Printf("%s %s\n", colourScheme.Header("SUBJECT:"), redact(commitInfo.Subject))
 
 	// Print full message if body exists
 	if commitInfo.Body != "" {
-		fmt.Printf("%s\n%s\n", colourScheme.Header("MESSAGE:"), commitInfo.Body)
+		fmt.Printf("%s\n%s\n", colourScheme.Header("MESSAGE:"), redact(commitInfo.Body))
+	}
+
+	fmt.Println(colourScheme.Header(divider))
+	fmt.Println() // Add a blank line before rule results
+}
+
+// printTagHeader prints a header with tag name, target and message information.
+func printTagHeader(tagInfo *model.TagInfo, colourScheme ColorScheme) {
+	kind := "lightweight"
+	if tagInfo.IsAnnotated {
+		kind = "annotated"
+	}
+
+	// Print a section divider
+	divider := strings.Repeat("=", 80)
+	fmt.Println(colourScheme.Header(divider))
+
+	// Print tag info header
+	fmt.Printf("%s %s (%s)\n", colourScheme.Header("TAG:"), colourScheme.Bold(tagInfo.Name), kind)
+
+	if !tagInfo.Target.IsZero() {
+		fmt.Printf("%s %s\n", colourScheme.Header("TARGET-SHA:"), tagInfo.Target.String()[:7])
+	}
+
+	if tagInfo.Subject != "" {
+		fmt.Printf("%s %s\n", colourScheme.Header("SUBJECT:"), tagInfo.Subject)
+	}
+
+	if tagInfo.Body != "" {
+		fmt.Printf("%s\n%s\n", colourScheme.Header("MESSAGE:"), tagInfo.Body)
+	}
+
+	fmt.Println(colourScheme.Header(divider))
+	fmt.Println() // Add a blank line before rule results
+}
+
+func printBranchHeader(branch string, colourScheme ColorScheme) {
+	if branch == "" {
+		branch = "(detached HEAD)"
 	}
 
+	// Print a section divider
+	divider := strings.Repeat("=", 80)
+	fmt.Println(colourScheme.Header(divider))
+
+	fmt.Printf("%s %s\n", colourScheme.Header("BRANCH:"), colourScheme.Bold(branch))
+
 	fmt.Println(colourScheme.Header(divider))
 	fmt.Println() // Add a blank line before rule results
 }
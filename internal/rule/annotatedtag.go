@@ -0,0 +1,103 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule
+
+import (
+	"fmt"
+
+	"github.com/itiquette/gommitlint/internal/model"
+)
+
+// AnnotatedTag enforces that a tag is an annotated tag object rather than a lightweight tag.
+//
+// Lightweight tags are plain references to a commit. They carry no tagger, date,
+// message or signature, so they cannot be attributed to anyone or verified.
+// Release tags should therefore be created with "git tag -a" or "git tag -s".
+//
+// Examples:
+//
+//   - "git tag -s v1.2.0 -m 'Release v1.2.0'" would pass
+//   - "git tag v1.2.0" would fail (lightweight tag)
+type AnnotatedTag struct {
+	tagName string
+	errors  []*model.ValidationError
+}
+
+// Name returns the name of the rule.
+func (rule AnnotatedTag) Name() string {
+	return "AnnotatedTag"
+}
+
+// Result returns a concise rule message.
+func (rule AnnotatedTag) Result() string {
+	if len(rule.errors) > 0 {
+		return "Lightweight tag"
+	}
+
+	return "Annotated tag"
+}
+
+// VerboseResult returns a more detailed explanation for verbose mode.
+func (rule AnnotatedTag) VerboseResult() string {
+	if len(rule.errors) > 0 {
+		return fmt.Sprintf("Tag '%s' is a lightweight tag without tagger, message or signature.", rule.tagName)
+	}
+
+	return fmt.Sprintf("Tag '%s' is an annotated tag object.", rule.tagName)
+}
+
+// addError adds a structured validation error.
+func (rule *AnnotatedTag) addError(code, message string, context map[string]string) {
+	err := model.NewValidationError("AnnotatedTag", code, message)
+
+	for key, value := range context {
+		_ = err.WithContext(key, value)
+	}
+
+	rule.errors = append(rule.errors, err)
+}
+
+// Errors returns validation errors.
+func (rule AnnotatedTag) Errors() []*model.ValidationError {
+	return rule.errors
+}
+
+// Help returns a description of how to fix the rule violation.
+func (rule AnnotatedTag) Help() string {
+	if len(rule.errors) == 0 {
+		return "No errors to fix"
+	}
+
+	return fmt.Sprintf(`Replace the lightweight tag with an annotated (and preferably signed) tag:
+
+git tag -d %[1]s
+git tag -s %[1]s -m "Release %[1]s"
+
+Use "git tag -a" instead of "git tag -s" if the tag should not be signed.`, rule.tagName)
+}
+
+// ValidateAnnotatedTag checks that a tag is an annotated tag object.
+//
+// Parameters:
+//   - tagName: The short name of the tag
+//   - isAnnotated: Whether the tag reference points to a tag object
+//
+// Returns:
+//   - An AnnotatedTag instance with validation results
+func ValidateAnnotatedTag(tagName string, isAnnotated bool) *AnnotatedTag {
+	rule := &AnnotatedTag{tagName: tagName}
+
+	if !isAnnotated {
+		rule.addError(
+			"lightweight_tag",
+			fmt.Sprintf("tag %s is a lightweight tag", tagName),
+			map[string]string{
+				"tag": tagName,
+			},
+		)
+	}
+
+	return rule
+}
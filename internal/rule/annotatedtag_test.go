@@ -0,0 +1,58 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+package rule_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/rule"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAnnotatedTag(t *testing.T) {
+	testCases := []struct {
+		name           string
+		tagName        string
+		isAnnotated    bool
+		expectedResult string
+		expectedCode   string
+	}{
+		{
+			name:           "Annotated tag",
+			tagName:        "v1.0.0",
+			isAnnotated:    true,
+			expectedResult: "Annotated tag",
+		},
+		{
+			name:           "Lightweight tag",
+			tagName:        "v1.0.0",
+			isAnnotated:    false,
+			expectedResult: "Lightweight tag",
+			expectedCode:   "lightweight_tag",
+		},
+	}
+
+	for _, tabletest := range testCases {
+		t.Run(tabletest.name, func(t *testing.T) {
+			result := rule.ValidateAnnotatedTag(tabletest.tagName, tabletest.isAnnotated)
+
+			assert.Equal(t, "AnnotatedTag", result.Name())
+			assert.Equal(t, tabletest.expectedResult, result.Result())
+			assert.Contains(t, result.VerboseResult(), tabletest.tagName)
+
+			if tabletest.expectedCode == "" {
+				assert.Empty(t, result.Errors())
+				assert.Equal(t, "No errors to fix", result.Help())
+
+				return
+			}
+
+			require.Len(t, result.Errors(), 1)
+			assert.Equal(t, tabletest.expectedCode, result.Errors()[0].Code)
+			assert.Equal(t, tabletest.tagName, result.Errors()[0].Context["tag"])
+			assert.Contains(t, result.Help(), "git tag -s "+tabletest.tagName)
+		})
+	}
+}
@@ -0,0 +1,232 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/model"
+)
+
+// autosquashPrefixes are the subject prefixes "git commit --fixup/--squash" create and
+// "git rebase --autosquash" consumes.
+var autosquashPrefixes = []string{"fixup! ", "squash! ", "amend! "}
+
+// DefaultWIPPatterns are the default regular expressions of work-in-progress subjects.
+var DefaultWIPPatterns = []string{
+	`(?i)^\W*wip\b`,
+	`(?i)^\W*te?mp\b`,
+	`(?i)\bdo[ -]not[ -]merge\b`,
+	`(?i)\bdnm\b`,
+}
+
+// AutosquashLeftoverConfig provides configuration for the AutosquashLeftover rule.
+type AutosquashLeftoverConfig struct {
+	// DenyPatterns lists regular expressions of subjects that must not be merged
+	DenyPatterns []string
+
+	// Allowed accepts leftovers, e.g. while working on a feature branch
+	Allowed bool
+}
+
+// DefaultAutosquashLeftoverConfig returns the default configuration.
+func DefaultAutosquashLeftoverConfig() AutosquashLeftoverConfig {
+	return AutosquashLeftoverConfig{
+		DenyPatterns: DefaultWIPPatterns,
+	}
+}
+
+// AutosquashLeftoverOption configures an AutosquashLeftoverConfig.
+type AutosquashLeftoverOption func(*AutosquashLeftoverConfig)
+
+// WithDenyPatterns replaces the default work-in-progress patterns.
+func WithDenyPatterns(patterns []string) AutosquashLeftoverOption {
+	return func(c *AutosquashLeftoverConfig) {
+		if len(patterns) > 0 {
+			c.DenyPatterns = patterns
+		}
+	}
+}
+
+// WithLeftoversAllowed sets whether leftovers are accepted.
+func WithLeftoversAllowed(allowed bool) AutosquashLeftoverOption {
+	return func(c *AutosquashLeftoverConfig) {
+		c.Allowed = allowed
+	}
+}
+
+// AutosquashLeftover rejects commits that were meant to be squashed or never meant to be
+// merged: "fixup!", "squash!" and "amend!" commits created by "git commit --fixup", and
+// work-in-progress subjects such as "WIP", "tmp" or "do not merge".
+//
+// Such commits would otherwise only fail rules like ConventionalCommit with a format error
+// that does not explain the real problem. The leftovers can be allowed while working on a
+// feature branch and rejected when validating the branch against its base branch.
+//
+// Examples:
+//
+//   - "fixup! feat: add login" would fail (run "git rebase -i --autosquash")
+//   - "WIP: parser" would fail
+//   - "feat: add login" would pass
+type AutosquashLeftover struct {
+	subject        string
+	allowed        bool
+	invalidPattern string
+	errors         []*model.ValidationError
+}
+
+// Name returns the rule name.
+func (rule AutosquashLeftover) Name() string {
+	return "AutosquashLeftover"
+}
+
+// Result returns a concise validation result.
+func (rule AutosquashLeftover) Result() string {
+	if len(rule.errors) > 0 {
+		return "Unsquashed commit"
+	}
+
+	return "No autosquash leftover"
+}
+
+// VerboseResult returns a more detailed explanation for verbose mode.
+func (rule AutosquashLeftover) VerboseResult() string {
+	if len(rule.errors) > 0 {
+		switch rule.errors[0].Code {
+		case "invalid_pattern":
+			return fmt.Sprintf("Deny pattern '%s' is not a valid regular expression.", rule.invalidPattern)
+		case "autosquash_commit":
+			return fmt.Sprintf("Commit '%s' is a %s commit that should have been squashed before merging.",
+				rule.subject, rule.errors[0].Context["prefix"])
+		default:
+			return fmt.Sprintf("Commit '%s' is marked as work in progress (matches '%s').",
+				rule.subject, rule.errors[0].Context["pattern"])
+		}
+	}
+
+	if rule.allowed {
+		return "Autosquash and work-in-progress commits are allowed outside base branch validation"
+	}
+
+	return "Commit is neither an autosquash nor a work-in-progress commit"
+}
+
+// addError adds a structured validation error.
+func (rule *AutosquashLeftover) addError(code, message string, context map[string]string) {
+	err := model.NewValidationError("AutosquashLeftover", code, message)
+
+	// Add any context values
+	for key, value := range context {
+		_ = err.WithContext(key, value)
+	}
+
+	rule.errors = append(rule.errors, err)
+}
+
+// Errors returns validation errors.
+func (rule AutosquashLeftover) Errors() []*model.ValidationError {
+	return rule.errors
+}
+
+// Help returns a description of how to fix the rule violation.
+func (rule AutosquashLeftover) Help() string {
+	if len(rule.errors) == 0 {
+		return "No errors to fix"
+	}
+
+	switch rule.errors[0].Code {
+	case "invalid_pattern":
+		return "Fix the 'autosquash.deny' setting so that every entry is a valid Go regular expression"
+
+	case "autosquash_commit":
+		return `Squash the fixup commits into the commits they fix before merging:
+
+  git rebase -i --autosquash <base-branch>
+
+Each "fixup!", "squash!" or "amend!" commit is moved next to its target and
+combined with it.`
+	}
+
+	return `Finish the work in progress before merging. Squash the commit into a
+finished commit, or reword it with 'git rebase -i <base-branch>' and a subject
+that describes the change.`
+}
+
+// ValidateAutosquashLeftover checks that a subject is neither an autosquash commit nor
+// marked as work in progress.
+//
+// Parameters:
+//   - subject: The commit subject line to validate
+//   - opts: Options overriding DefaultAutosquashLeftoverConfig
+//
+// Returns:
+//   - An AutosquashLeftover instance with validation results
+func ValidateAutosquashLeftover(subject string, opts ...AutosquashLeftoverOption) *AutosquashLeftover {
+	config := DefaultAutosquashLeftoverConfig()
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	rule := &AutosquashLeftover{subject: subject, allowed: config.Allowed}
+
+	denyRegexes := make([]*regexp.Regexp, 0, len(config.DenyPatterns))
+
+	for _, pattern := range config.DenyPatterns {
+		denyRegex, err := regexp.Compile(pattern)
+		if err != nil {
+			rule.invalidPattern = pattern
+			rule.addError(
+				"invalid_pattern",
+				fmt.Sprintf("invalid deny pattern %q: %s", pattern, err),
+				map[string]string{
+					"pattern": pattern,
+					"error":   err.Error(),
+				},
+			)
+
+			return rule
+		}
+
+		denyRegexes = append(denyRegexes, denyRegex)
+	}
+
+	if config.Allowed {
+		return rule
+	}
+
+	for _, prefix := range autosquashPrefixes {
+		if strings.HasPrefix(subject, prefix) {
+			rule.addError(
+				"autosquash_commit",
+				fmt.Sprintf("%s commit must be squashed before merging", strings.TrimSpace(prefix)),
+				map[string]string{
+					"subject": subject,
+					"prefix":  strings.TrimSpace(prefix),
+				},
+			)
+
+			return rule
+		}
+	}
+
+	for _, denyRegex := range denyRegexes {
+		if denyRegex.MatchString(subject) {
+			rule.addError(
+				"wip_commit",
+				"work-in-progress commit must not be merged",
+				map[string]string{
+					"subject": subject,
+					"pattern": denyRegex.String(),
+				},
+			)
+
+			return rule
+		}
+	}
+
+	return rule
+}
@@ -0,0 +1,116 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/rule"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAutosquashLeftover(t *testing.T) {
+	tests := []struct {
+		name       string
+		subject    string
+		opts       []rule.AutosquashLeftoverOption
+		wantCode   string
+		wantInHelp string
+	}{
+		{
+			name:    "regular commit",
+			subject: "feat: add login",
+		},
+		{
+			name:       "fixup commit",
+			subject:    "fixup! feat: add login",
+			wantCode:   "autosquash_commit",
+			wantInHelp: "--autosquash",
+		},
+		{
+			name:     "squash commit",
+			subject:  "squash! feat: add login",
+			wantCode: "autosquash_commit",
+		},
+		{
+			name:     "amend commit",
+			subject:  "amend! feat: add login",
+			wantCode: "autosquash_commit",
+		},
+		{
+			name:       "WIP subject",
+			subject:    "WIP: parser",
+			wantCode:   "wip_commit",
+			wantInHelp: "work in progress",
+		},
+		{
+			name:     "tmp subject",
+			subject:  "tmp",
+			wantCode: "wip_commit",
+		},
+		{
+			name:     "do not merge",
+			subject:  "feat: new parser (do not merge)",
+			wantCode: "wip_commit",
+		},
+		{
+			name:    "words containing wip are fine",
+			subject: "fix: wipe cache on logout",
+		},
+		{
+			name:    "template is not tmp",
+			subject: "template engine for e-mails",
+		},
+		{
+			name:    "leftovers allowed",
+			subject: "fixup! feat: add login",
+			opts:    []rule.AutosquashLeftoverOption{rule.WithLeftoversAllowed(true)},
+		},
+		{
+			name:     "custom deny list replaces defaults",
+			subject:  "feat: HACK around parser",
+			opts:     []rule.AutosquashLeftoverOption{rule.WithDenyPatterns([]string{`\bHACK\b`})},
+			wantCode: "wip_commit",
+		},
+		{
+			name:    "custom deny list drops WIP default",
+			subject: "WIP: parser",
+			opts:    []rule.AutosquashLeftoverOption{rule.WithDenyPatterns([]string{`\bHACK\b`})},
+		},
+		{
+			name:     "autosquash prefixes are always denied",
+			subject:  "fixup! feat: add login",
+			opts:     []rule.AutosquashLeftoverOption{rule.WithDenyPatterns([]string{`\bHACK\b`})},
+			wantCode: "autosquash_commit",
+		},
+		{
+			name:     "invalid deny pattern",
+			subject:  "feat: add login",
+			opts:     []rule.AutosquashLeftoverOption{rule.WithDenyPatterns([]string{`(`})},
+			wantCode: "invalid_pattern",
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			result := rule.ValidateAutosquashLeftover(tabletest.subject, tabletest.opts...)
+
+			if tabletest.wantCode == "" {
+				require.Empty(t, result.Errors())
+				require.Equal(t, "No autosquash leftover", result.Result())
+
+				return
+			}
+
+			require.Len(t, result.Errors(), 1)
+			require.Equal(t, tabletest.wantCode, result.Errors()[0].Code)
+			require.Equal(t, "Unsquashed commit", result.Result())
+
+			if tabletest.wantInHelp != "" {
+				require.Contains(t, result.Help(), tabletest.wantInHelp)
+			}
+		})
+	}
+}
@@ -0,0 +1,345 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule
+
+import (
+	"cmp"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/itiquette/gommitlint/internal/model"
+)
+
+// Contexts in which banned terms are allowed.
+const (
+	// ContextCode is inline code in backticks and fenced code blocks.
+	ContextCode = "code"
+
+	// ContextQuotes is text in double or single quotes.
+	ContextQuotes = "quotes"
+
+	// ContextURLs is URLs.
+	ContextURLs = "urls"
+)
+
+// DefaultAllowedContexts are the contexts in which banned terms are allowed by default.
+var DefaultAllowedContexts = []string{ContextCode}
+
+// contextRegexes match the text of each allowed context.
+var contextRegexes = map[string]*regexp.Regexp{
+	ContextCode:   regexp.MustCompile("(?s)```.*?(?:```|\\z)|`[^`\n]*`"),
+	ContextQuotes: regexp.MustCompile(`"[^"\n]*"|\B'[^'\n]+'\B`),
+	ContextURLs:   regexp.MustCompile(`\b[a-z][a-z0-9+.-]*://\S+`),
+}
+
+// BannedTerm is a term that must not be used, with suggested alternatives.
+type BannedTerm struct {
+	Term        string   // Word or phrase, matched as whole words and case-insensitively
+	Suggestions []string // Alternatives to use instead
+}
+
+// InclusiveLanguageTerms are non-inclusive terms with suggested replacements.
+var InclusiveLanguageTerms = []BannedTerm{
+	{Term: "master", Suggestions: []string{"main", "primary"}},
+	{Term: "slave", Suggestions: []string{"replica", "secondary"}},
+	{Term: "slaves", Suggestions: []string{"replicas", "secondaries"}},
+	{Term: "whitelist", Suggestions: []string{"allowlist"}},
+	{Term: "whitelisted", Suggestions: []string{"allowlisted"}},
+	{Term: "whitelisting", Suggestions: []string{"allowlisting"}},
+	{Term: "blacklist", Suggestions: []string{"denylist", "blocklist"}},
+	{Term: "blacklisted", Suggestions: []string{"denylisted", "blocked"}},
+	{Term: "blacklisting", Suggestions: []string{"denylisting", "blocking"}},
+	{Term: "grandfathered", Suggestions: []string{"legacy", "exempt"}},
+	{Term: "sanity check", Suggestions: []string{"confidence check", "consistency check"}},
+	{Term: "dummy value", Suggestions: []string{"placeholder value"}},
+}
+
+// BannedTermsConfig provides configuration for the BannedTerms rule.
+type BannedTermsConfig struct {
+	// Terms lists the banned terms
+	Terms []BannedTerm
+
+	// AllowedContexts lists the contexts in which banned terms are allowed
+	// (ContextCode, ContextQuotes, ContextURLs)
+	AllowedContexts []string
+}
+
+// DefaultBannedTermsConfig returns the default configuration.
+func DefaultBannedTermsConfig() BannedTermsConfig {
+	return BannedTermsConfig{
+		AllowedContexts: DefaultAllowedContexts,
+	}
+}
+
+// BannedTermsOption configures a BannedTermsConfig.
+type BannedTermsOption func(*BannedTermsConfig)
+
+// WithBannedTerms adds banned terms.
+func WithBannedTerms(terms []BannedTerm) BannedTermsOption {
+	return func(c *BannedTermsConfig) {
+		c.Terms = append(c.Terms, terms...)
+	}
+}
+
+// WithAllowedContexts replaces the default contexts in which banned terms are allowed.
+func WithAllowedContexts(contexts []string) BannedTermsOption {
+	return func(c *BannedTermsConfig) {
+		if contexts != nil {
+			c.AllowedContexts = contexts
+		}
+	}
+}
+
+// bannedTermMatch is a banned term found in the message.
+type bannedTermMatch struct {
+	term     BannedTerm
+	original string
+	offset   int
+	line     int
+}
+
+// BannedTerms rejects banned words and phrases in commit messages.
+//
+// Teams keep lists of terms that must not appear in the history: profanity, customer
+// names, or non-inclusive terms such as master/slave. Terms are matched as whole words
+// and case-insensitively, so "Master" is found but "mastery" is not. Each term can
+// name alternatives, which are suggested in the verbose result.
+//
+// Terms inside allowed contexts, by default inline code and code blocks, are not
+// reported, so that code and commands can be quoted as they are.
+//
+// Examples:
+//
+//   - With the inclusive language terms:
+//     "Promote replica to primary" would pass
+//     "Promote slave to master" would fail (use "replica", "main" or "primary")
+//     "Rename the `master` branch" would pass (the term is in backticks)
+type BannedTerms struct {
+	matches []bannedTermMatch
+	errors  []*model.ValidationError
+}
+
+// Name returns the rule name.
+func (rule BannedTerms) Name() string {
+	return "BannedTerms"
+}
+
+// Result returns a concise validation result.
+func (rule BannedTerms) Result() string {
+	if len(rule.errors) == 0 {
+		return "No banned terms"
+	}
+
+	if rule.errors[0].Code == "invalid_context" {
+		return "Invalid banned terms configuration"
+	}
+
+	return fmt.Sprintf("%d banned term(s)", len(rule.errors))
+}
+
+// VerboseResult returns a more detailed explanation for verbose mode.
+func (rule BannedTerms) VerboseResult() string {
+	if len(rule.errors) == 0 {
+		return "No banned words or phrases found"
+	}
+
+	if rule.errors[0].Code == "invalid_context" {
+		return fmt.Sprintf("Unknown allowed context '%s'. Supported contexts are: code, quotes, urls",
+			rule.errors[0].Context["context"])
+	}
+
+	var stringBuilder strings.Builder
+
+	stringBuilder.WriteString(fmt.Sprintf("Found %d banned term(s):", len(rule.matches)))
+
+	for _, match := range rule.matches {
+		stringBuilder.WriteString(fmt.Sprintf("\n- '%s' on line %d", match.original, match.line))
+
+		if len(match.term.Suggestions) > 0 {
+			stringBuilder.WriteString(", use '" + strings.Join(match.term.Suggestions, "' or '") + "' instead")
+		}
+	}
+
+	return stringBuilder.String()
+}
+
+// addError adds a structured validation error.
+func (rule *BannedTerms) addError(code, message string, context map[string]string) {
+	err := model.NewValidationError("BannedTerms", code, message)
+
+	// Add any context values
+	for key, value := range context {
+		_ = err.WithContext(key, value)
+	}
+
+	rule.errors = append(rule.errors, err)
+}
+
+// Errors returns validation errors.
+func (rule BannedTerms) Errors() []*model.ValidationError {
+	return rule.errors
+}
+
+// Help returns a description of how to fix the rule violation.
+func (rule BannedTerms) Help() string {
+	if len(rule.errors) == 0 {
+		return "No errors to fix"
+	}
+
+	if rule.errors[0].Code == "invalid_context" {
+		return "Use only the contexts 'code', 'quotes' and 'urls' in 'banned-terms.allow-in'."
+	}
+
+	var replacements strings.Builder
+
+	replacements.WriteString("Reword the commit message without the banned terms:\n")
+
+	for _, err := range rule.errors {
+		replacements.WriteString("\n- ")
+		replacements.WriteString(err.Error())
+
+		if corrected := err.Context["corrected"]; corrected != "" {
+			replacements.WriteString(fmt.Sprintf(" (Replace '%s' with '%s')", err.Context["original"], corrected))
+		}
+	}
+
+	replacements.WriteString("\n\nTo refer to code, such as a branch or an API named with a banned term,\nput it in backticks: `master`.")
+
+	return replacements.String()
+}
+
+// ValidateBannedTerms checks a commit message for banned terms.
+//
+// Parameters:
+//   - message: The full commit message
+//   - opts: Options with the banned terms and the allowed contexts
+//
+// Returns:
+//   - A BannedTerms instance with validation results
+func ValidateBannedTerms(message string, opts ...BannedTermsOption) *BannedTerms {
+	config := DefaultBannedTermsConfig()
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	rule := &BannedTerms{}
+
+	masked := message
+
+	for _, context := range config.AllowedContexts {
+		contextRegex, found := contextRegexes[context]
+		if !found {
+			rule.addError(
+				"invalid_context",
+				fmt.Sprintf("unknown allowed context: %q", context),
+				map[string]string{
+					"context":            context,
+					"supported_contexts": "code,quotes,urls",
+				},
+			)
+
+			return rule
+		}
+
+		masked = maskMatches(contextRegex, masked)
+	}
+
+	for _, term := range config.Terms {
+		termRegex := bannedTermRegex(term.Term)
+		if termRegex == nil {
+			continue
+		}
+
+		for _, loc := range termRegex.FindAllStringIndex(masked, -1) {
+			rule.matches = append(rule.matches, bannedTermMatch{
+				term:     term,
+				original: message[loc[0]:loc[1]],
+				offset:   loc[0],
+				line:     strings.Count(message[:loc[0]], "\n") + 1,
+			})
+		}
+	}
+
+	slices.SortFunc(rule.matches, func(a, b bannedTermMatch) int {
+		return cmp.Compare(a.offset, b.offset)
+	})
+
+	for _, match := range rule.matches {
+		corrected := ""
+		if len(match.term.Suggestions) > 0 {
+			corrected = match.term.Suggestions[0]
+		}
+
+		rule.addError(
+			"banned_term",
+			fmt.Sprintf("`%s` is a banned term", match.original),
+			map[string]string{
+				"term":        match.term.Term,
+				"original":    match.original,
+				"corrected":   corrected,
+				"suggestions": strings.Join(match.term.Suggestions, ","),
+				"line":        strconv.Itoa(match.line),
+			},
+		)
+	}
+
+	return rule
+}
+
+// bannedTermRegex returns a whole-word, case-insensitive regular expression of a term,
+// in which any whitespace separates the words of a phrase. It returns nil for an empty term.
+func bannedTermRegex(term string) *regexp.Regexp {
+	words := strings.Fields(term)
+	if len(words) == 0 {
+		return nil
+	}
+
+	for index, word := range words {
+		words[index] = regexp.QuoteMeta(word)
+	}
+
+	pattern := strings.Join(words, `\s+`)
+
+	// Word boundaries only apply next to word characters, e.g. not after "C++"
+	term = strings.TrimSpace(term)
+
+	if first, _ := utf8.DecodeRuneInString(term); isWordRune(first) {
+		pattern = `\b` + pattern
+	}
+
+	if last, _ := utf8.DecodeLastRuneInString(term); isWordRune(last) {
+		pattern += `\b`
+	}
+
+	return regexp.MustCompile("(?i)" + pattern)
+}
+
+// isWordRune reports whether a rune is a word character in the sense of \b.
+func isWordRune(char rune) bool {
+	return char == '_' || (char < utf8.RuneSelf && (unicode.IsLetter(char) || unicode.IsDigit(char)))
+}
+
+// maskMatches replaces the matches of a regular expression with spaces, keeping line
+// breaks and byte offsets.
+func maskMatches(contextRegex *regexp.Regexp, text string) string {
+	return contextRegex.ReplaceAllStringFunc(text, func(match string) string {
+		var masked strings.Builder
+
+		for _, char := range match {
+			if char == '\n' {
+				masked.WriteRune(char)
+			} else {
+				masked.WriteString(strings.Repeat(" ", utf8.RuneLen(char)))
+			}
+		}
+
+		return masked.String()
+	})
+}
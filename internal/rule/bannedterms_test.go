@@ -0,0 +1,127 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/rule"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateBannedTerms(t *testing.T) {
+	inclusive := rule.WithBannedTerms(rule.InclusiveLanguageTerms)
+	customers := rule.WithBannedTerms([]rule.BannedTerm{{Term: "Acme Corp"}, {Term: "C++"}})
+
+	tests := []struct {
+		name          string
+		message       string
+		opts          []rule.BannedTermsOption
+		wantOriginals []string
+	}{
+		{
+			name:    "no terms configured",
+			message: "Promote slave to master",
+		},
+		{
+			name:    "clean message",
+			message: "Promote replica to primary",
+			opts:    []rule.BannedTermsOption{inclusive},
+		},
+		{
+			name:          "case-insensitive whole words",
+			message:       "Promote Slave to MASTER\n\nThe mastery of the whitelist.",
+			opts:          []rule.BannedTermsOption{inclusive},
+			wantOriginals: []string{"Slave", "MASTER", "whitelist"},
+		},
+		{
+			name:          "phrase across whitespace",
+			message:       "Add a sanity\ncheck for uploads",
+			opts:          []rule.BannedTermsOption{inclusive},
+			wantOriginals: []string{"sanity\ncheck"},
+		},
+		{
+			name:    "inline code",
+			message: "Rename the `master` branch",
+			opts:    []rule.BannedTermsOption{inclusive},
+		},
+		{
+			name:    "code block",
+			message: "Rename the default branch\n\n```\ngit branch -m master main\n```",
+			opts:    []rule.BannedTermsOption{inclusive},
+		},
+		{
+			name:          "quotes are not allowed by default",
+			message:       "Merge branch 'master' into feature",
+			opts:          []rule.BannedTermsOption{inclusive},
+			wantOriginals: []string{"master"},
+		},
+		{
+			name:    "quotes allowed",
+			message: "Merge branch 'master' into \"feature\"\n\nDon't touch it.",
+			opts:    []rule.BannedTermsOption{inclusive, rule.WithAllowedContexts([]string{rule.ContextQuotes})},
+		},
+		{
+			name:    "URLs allowed",
+			message: "Fix link\n\nSee https://example.com/blob/master/README.md",
+			opts:    []rule.BannedTermsOption{inclusive, rule.WithAllowedContexts([]string{rule.ContextURLs})},
+		},
+		{
+			name:          "code no longer allowed",
+			message:       "Rename the `master` branch",
+			opts:          []rule.BannedTermsOption{inclusive, rule.WithAllowedContexts([]string{})},
+			wantOriginals: []string{"master"},
+		},
+		{
+			name:          "custom terms",
+			message:       "Fix import for acme  corp\n\nPort the C++ parser.",
+			opts:          []rule.BannedTermsOption{customers},
+			wantOriginals: []string{"acme  corp", "C++"},
+		},
+		{
+			name:          "non-ASCII text before a term in code",
+			message:       "Rename `maître` and the master branch",
+			opts:          []rule.BannedTermsOption{inclusive},
+			wantOriginals: []string{"master"},
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			result := rule.ValidateBannedTerms(tabletest.message, tabletest.opts...)
+
+			originals := make([]string, 0, len(result.Errors()))
+			for _, err := range result.Errors() {
+				originals = append(originals, err.Context["original"])
+			}
+
+			if len(tabletest.wantOriginals) == 0 {
+				require.Empty(t, originals)
+				require.Equal(t, "No banned terms", result.Result())
+				require.Equal(t, "No errors to fix", result.Help())
+
+				return
+			}
+
+			require.Equal(t, tabletest.wantOriginals, originals)
+			require.NotEmpty(t, result.VerboseResult())
+			require.NotEmpty(t, result.Help())
+		})
+	}
+}
+
+func TestBannedTermsVerboseResult(t *testing.T) {
+	result := rule.ValidateBannedTerms("Promote slave\n\nto master", rule.WithBannedTerms(rule.InclusiveLanguageTerms))
+
+	require.Equal(t, "2 banned term(s)", result.Result())
+	require.Equal(t, `Found 2 banned term(s):
+- 'slave' on line 1, use 'replica' or 'secondary' instead
+- 'master' on line 3, use 'main' or 'primary' instead`, result.VerboseResult())
+	require.Equal(t, "replica", result.Errors()[0].Context["corrected"])
+
+	result = rule.ValidateBannedTerms("Promote slave", rule.WithAllowedContexts([]string{"comments"}))
+	require.Equal(t, "invalid_context", result.Errors()[0].Code)
+	require.Equal(t, "Unknown allowed context 'comments'. Supported contexts are: code, quotes, urls", result.VerboseResult())
+}
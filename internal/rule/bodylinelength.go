@@ -0,0 +1,310 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/itiquette/gommitlint/internal/model"
+)
+
+// DefaultMaxBodyLineLength is the default maximum number of characters allowed
+// on a commit body line, following the common Git convention of wrapping at 72 columns.
+const DefaultMaxBodyLineLength = 72
+
+// urlRegex matches a URL, which cannot be wrapped without breaking it.
+var urlRegex = regexp.MustCompile(`[A-Za-z][A-Za-z0-9+.-]*://\S+`)
+
+// BodyLineLengthConfig provides configuration for the BodyLineLength rule.
+type BodyLineLengthConfig struct {
+	// MaxLength is the maximum number of characters per body line
+	MaxLength int
+
+	// ExemptURLs skips lines containing a URL
+	ExemptURLs bool
+
+	// ExemptCodeBlocks skips indented lines and lines inside ``` or ~~~ fenced blocks
+	ExemptCodeBlocks bool
+
+	// ExemptQuotes skips quoted lines starting with ">"
+	ExemptQuotes bool
+
+	// ExemptTrailers skips the trailer block, e.g. "Signed-off-by:" lines in the last paragraph
+	ExemptTrailers bool
+
+	// ExemptPatterns lists regular expressions of additional lines to skip
+	ExemptPatterns []string
+}
+
+// DefaultBodyLineLengthConfig returns the default configuration with all exemptions enabled.
+func DefaultBodyLineLengthConfig() BodyLineLengthConfig {
+	return BodyLineLengthConfig{
+		MaxLength:        DefaultMaxBodyLineLength,
+		ExemptURLs:       true,
+		ExemptCodeBlocks: true,
+		ExemptQuotes:     true,
+		ExemptTrailers:   true,
+	}
+}
+
+// BodyLineLength enforces a maximum number of characters on each line of the commit body.
+//
+// Wrapped bodies read well in "git log", in e-mail based workflows and in terminals of
+// any width. Some lines cannot reasonably be wrapped, so by default the rule skips:
+//
+//   - lines containing a URL
+//   - code blocks, either indented by a tab or four spaces, or fenced with ``` or ~~~
+//   - quoted lines starting with ">"
+//   - trailer lines such as "Signed-off-by:" or "Co-authored-by:" in the last paragraph
+//
+// Each offending line is reported as a separate error with its line number in the full
+// commit message (the subject is line 1). Like SubjectLength, the length is measured
+// in Unicode code points rather than bytes.
+//
+// Examples:
+//
+//   - With maxLength=72:
+//     "Explain why the cache is flushed before the migration runs." would pass
+//     A single 90 character sentence on one line would fail
+//     "See https://example.com/a/very/long/link/that/cannot/be/wrapped/at/all" would pass
+type BodyLineLength struct {
+	config         BodyLineLengthConfig
+	checked        int
+	exempted       int
+	invalidPattern string
+	errors         []*model.ValidationError
+}
+
+// Name returns the rule name.
+func (rule BodyLineLength) Name() string {
+	return "BodyLineLength"
+}
+
+// Result returns a concise validation result.
+func (rule BodyLineLength) Result() string {
+	if len(rule.errors) > 0 {
+		return "Body lines too long"
+	}
+
+	return "Body line length OK"
+}
+
+// VerboseResult returns a more detailed explanation for verbose mode.
+func (rule BodyLineLength) VerboseResult() string {
+	if len(rule.errors) > 0 {
+		if rule.errors[0].Code == "invalid_pattern" {
+			return fmt.Sprintf("Exemption pattern '%s' is not a valid regular expression.", rule.invalidPattern)
+		}
+
+		lines := make([]string, 0, len(rule.errors))
+		for _, err := range rule.errors {
+			lines = append(lines, fmt.Sprintf("line %s (%s chars)",
+				err.Context["line_number"], err.Context["actual_length"]))
+		}
+
+		return fmt.Sprintf("%d body line(s) exceed %d characters: %s",
+			len(rule.errors), rule.config.MaxLength, strings.Join(lines, ", "))
+	}
+
+	return fmt.Sprintf("All %d checked body lines are within %d characters (%d exempt)",
+		rule.checked, rule.config.MaxLength, rule.exempted)
+}
+
+// addError adds a structured validation error.
+func (rule *BodyLineLength) addError(code, message string, context map[string]string) {
+	err := model.NewValidationError("BodyLineLength", code, message)
+
+	// Add any context values
+	for key, value := range context {
+		_ = err.WithContext(key, value)
+	}
+
+	rule.errors = append(rule.errors, err)
+}
+
+// Errors returns validation errors.
+func (rule BodyLineLength) Errors() []*model.ValidationError {
+	return rule.errors
+}
+
+// Help returns a description of how to fix the rule violation.
+func (rule BodyLineLength) Help() string {
+	if len(rule.errors) == 0 {
+		return "No errors to fix"
+	}
+
+	if rule.errors[0].Code == "invalid_pattern" {
+		return "Fix the 'body.line-length-exemptions.patterns' setting so that every entry is a valid Go regular expression"
+	}
+
+	return fmt.Sprintf(`Wrap the commit body at %d characters.
+Most editors can do this for you, e.g. "gq" in Vim or "fill-paragraph" (M-q) in Emacs.
+
+Lines that cannot be wrapped are exempt:
+- lines containing a URL
+- code blocks, indented by four spaces or fenced with `+"```"+`
+- quoted lines starting with ">"
+- trailers such as "Signed-off-by:" at the end of the message`, rule.config.MaxLength)
+}
+
+// BodyLineLengthOption configures a BodyLineLengthConfig.
+type BodyLineLengthOption func(*BodyLineLengthConfig)
+
+// WithMaxBodyLineLength sets the maximum body line length (0 means use default).
+func WithMaxBodyLineLength(maxLength int) BodyLineLengthOption {
+	return func(c *BodyLineLengthConfig) {
+		if maxLength > 0 {
+			c.MaxLength = maxLength
+		}
+	}
+}
+
+// WithURLExemption sets whether lines containing a URL are exempt.
+func WithURLExemption(exempt bool) BodyLineLengthOption {
+	return func(c *BodyLineLengthConfig) {
+		c.ExemptURLs = exempt
+	}
+}
+
+// WithCodeBlockExemption sets whether indented and fenced code blocks are exempt.
+func WithCodeBlockExemption(exempt bool) BodyLineLengthOption {
+	return func(c *BodyLineLengthConfig) {
+		c.ExemptCodeBlocks = exempt
+	}
+}
+
+// WithQuoteExemption sets whether quoted lines are exempt.
+func WithQuoteExemption(exempt bool) BodyLineLengthOption {
+	return func(c *BodyLineLengthConfig) {
+		c.ExemptQuotes = exempt
+	}
+}
+
+// WithTrailerExemption sets whether trailer lines are exempt.
+func WithTrailerExemption(exempt bool) BodyLineLengthOption {
+	return func(c *BodyLineLengthConfig) {
+		c.ExemptTrailers = exempt
+	}
+}
+
+// WithExemptPatterns adds regular expressions of lines that are exempt.
+func WithExemptPatterns(patterns []string) BodyLineLengthOption {
+	return func(c *BodyLineLengthConfig) {
+		c.ExemptPatterns = append(c.ExemptPatterns, patterns...)
+	}
+}
+
+// ValidateBodyLineLength checks the length of every body line of a commit message.
+//
+// Parameters:
+//   - message: The full commit message, including the subject line
+//   - opts: Options overriding DefaultBodyLineLengthConfig
+//
+// Returns:
+//   - A BodyLineLength instance with one error per offending line
+func ValidateBodyLineLength(message string, opts ...BodyLineLengthOption) *BodyLineLength {
+	config := DefaultBodyLineLengthConfig()
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	rule := &BodyLineLength{config: config}
+
+	exemptRegexes := make([]*regexp.Regexp, 0, len(config.ExemptPatterns))
+
+	for _, pattern := range config.ExemptPatterns {
+		exemptRegex, err := regexp.Compile(pattern)
+		if err != nil {
+			rule.invalidPattern = pattern
+			rule.addError(
+				"invalid_pattern",
+				fmt.Sprintf("invalid exemption pattern %q: %s", pattern, err),
+				map[string]string{
+					"pattern": pattern,
+					"error":   err.Error(),
+				},
+			)
+
+			return rule
+		}
+
+		exemptRegexes = append(exemptRegexes, exemptRegex)
+	}
+
+	lines := strings.Split(strings.TrimRight(message, "\n"), "\n")
+	trailerStart := len(lines)
+
+	if trailers := model.ParseTrailers(message); trailers.StartLine > 0 {
+		trailerStart = trailers.StartLine - 1
+	}
+
+	inFence := false
+
+	// Line 1 is the subject, which SubjectLength checks
+	for index := 1; index < len(lines); index++ {
+		line := strings.TrimRight(lines[index], "\r")
+		trimmed := strings.TrimSpace(line)
+
+		isFence := strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~")
+		if isFence {
+			inFence = !inFence
+		}
+
+		if trimmed == "" {
+			continue
+		}
+
+		exempt := (config.ExemptCodeBlocks && (isFence || inFence || isIndentedCode(line))) ||
+			(config.ExemptQuotes && strings.HasPrefix(trimmed, ">")) ||
+			(config.ExemptURLs && urlRegex.MatchString(line)) ||
+			(config.ExemptTrailers && index >= trailerStart) ||
+			matchesAny(exemptRegexes, line)
+
+		if exempt {
+			rule.exempted++
+
+			continue
+		}
+
+		rule.checked++
+
+		lineLength := utf8.RuneCountInString(line)
+		if lineLength > config.MaxLength {
+			rule.addError(
+				"body_line_too_long",
+				fmt.Sprintf("body line %d too long: %d characters (maximum allowed: %d)",
+					index+1, lineLength, config.MaxLength),
+				map[string]string{
+					"line_number":   strconv.Itoa(index + 1),
+					"actual_length": strconv.Itoa(lineLength),
+					"max_length":    strconv.Itoa(config.MaxLength),
+					"line":          line,
+				},
+			)
+		}
+	}
+
+	return rule
+}
+
+// isIndentedCode reports whether a line is part of an indented code block.
+func isIndentedCode(line string) bool {
+	return strings.HasPrefix(line, "\t") || strings.HasPrefix(line, "    ")
+}
+
+// matchesAny reports whether any of the regular expressions matches line.
+func matchesAny(regexes []*regexp.Regexp, line string) bool {
+	for _, re := range regexes {
+		if re.MatchString(line) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+package rule_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/rule"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateBodyLineLength(t *testing.T) {
+	longLine := strings.Repeat("word ", 16) + "end" // 83 characters
+
+	testCases := []struct {
+		name          string
+		message       string
+		opts          []rule.BodyLineLengthOption
+		expectedLines []string
+		expectedCode  string
+	}{
+		{
+			name:    "Wrapped body",
+			message: "Fix cache\n\nFlush the cache before the migration runs so that\nstale entries are not served.\n",
+		},
+		{
+			name:          "Long body line",
+			message:       "Fix cache\n\nShort line.\n" + longLine + "\n",
+			expectedLines: []string{"4"},
+			expectedCode:  "body_line_too_long",
+		},
+		{
+			name:          "Each long line is reported",
+			message:       "Fix cache\n\n" + longLine + "\nShort line.\n" + longLine,
+			expectedLines: []string{"3", "5"},
+			expectedCode:  "body_line_too_long",
+		},
+		{
+			name:    "Subject is not checked",
+			message: longLine + "\n\nShort body.",
+		},
+		{
+			name:    "Runes are counted rather than bytes",
+			message: "Fix cache\n\n" + strings.Repeat("å", 72),
+		},
+		{
+			name:          "Runes above the limit",
+			message:       "Fix cache\n\n" + strings.Repeat("å", 73),
+			expectedLines: []string{"3"},
+			expectedCode:  "body_line_too_long",
+		},
+		{
+			name:    "URL is exempt",
+			message: "Fix cache\n\nSee https://example.com/" + strings.Repeat("path/", 20) + " for details.",
+		},
+		{
+			name:    "Indented code is exempt",
+			message: "Fix cache\n\nRun:\n\n    " + longLine + "\n\tgo test ./... " + longLine,
+		},
+		{
+			name:    "Fenced code is exempt",
+			message: "Fix cache\n\nRun:\n\n```sh\n" + longLine + "\n```\n",
+		},
+		{
+			name:    "Quote is exempt",
+			message: "Fix cache\n\nThe reporter wrote:\n\n> " + longLine,
+		},
+		{
+			name:    "Trailers are exempt",
+			message: "Fix cache\n\nShort body.\n\nSigned-off-by: " + longLine + " <dev@example.com>\nCo-authored-by: Someone <someone@example.com>",
+		},
+		{
+			name:          "Trailer-like line outside the trailer block",
+			message:       "Fix cache\n\nNote: " + longLine + "\n\nShort closing paragraph.",
+			expectedLines: []string{"3"},
+			expectedCode:  "body_line_too_long",
+		},
+		{
+			name:          "Disabled exemption",
+			message:       "Fix cache\n\n> " + longLine,
+			opts:          []rule.BodyLineLengthOption{rule.WithQuoteExemption(false)},
+			expectedLines: []string{"3"},
+			expectedCode:  "body_line_too_long",
+		},
+		{
+			name:    "Custom exempt pattern",
+			message: "Fix cache\n\nBENCH: " + longLine,
+			opts:    []rule.BodyLineLengthOption{rule.WithExemptPatterns([]string{`^BENCH: `})},
+		},
+		{
+			name:          "Custom max length",
+			message:       "Fix cache\n\nFlush the cache before the migration runs.",
+			opts:          []rule.BodyLineLengthOption{rule.WithMaxBodyLineLength(20)},
+			expectedLines: []string{"3"},
+			expectedCode:  "body_line_too_long",
+		},
+		{
+			name:         "Invalid exempt pattern",
+			message:      "Fix cache\n\nShort body.",
+			opts:         []rule.BodyLineLengthOption{rule.WithExemptPatterns([]string{`(`})},
+			expectedCode: "invalid_pattern",
+		},
+	}
+
+	for _, tabletest := range testCases {
+		t.Run(tabletest.name, func(t *testing.T) {
+			result := rule.ValidateBodyLineLength(tabletest.message, tabletest.opts...)
+
+			assert.Equal(t, "BodyLineLength", result.Name())
+
+			if tabletest.expectedCode == "" {
+				assert.Empty(t, result.Errors())
+				assert.Equal(t, "Body line length OK", result.Result())
+				assert.Equal(t, "No errors to fix", result.Help())
+
+				return
+			}
+
+			require.NotEmpty(t, result.Errors())
+			assert.Equal(t, "Body lines too long", result.Result())
+			assert.NotEqual(t, "No errors to fix", result.Help())
+
+			lineNumbers := make([]string, 0, len(result.Errors()))
+			for _, err := range result.Errors() {
+				assert.Equal(t, tabletest.expectedCode, err.Code)
+
+				if err.Code == "body_line_too_long" {
+					lineNumbers = append(lineNumbers, err.Context["line_number"])
+					assert.NotEmpty(t, err.Context["actual_length"])
+					assert.NotEmpty(t, err.Context["max_length"])
+				}
+			}
+
+			if tabletest.expectedLines != nil {
+				assert.Equal(t, tabletest.expectedLines, lineNumbers)
+				assert.Contains(t, result.VerboseResult(), "line "+tabletest.expectedLines[0])
+			}
+		})
+	}
+}
+
+func TestBodyLineLengthContext(t *testing.T) {
+	result := rule.ValidateBodyLineLength("Fix\n\n" + strings.Repeat("ö", 80))
+
+	require.Len(t, result.Errors(), 1)
+
+	context := result.Errors()[0].Context
+	assert.Equal(t, "3", context["line_number"])
+	assert.Equal(t, "80", context["actual_length"])
+	assert.Equal(t, "72", context["max_length"])
+}
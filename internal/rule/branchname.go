@@ -0,0 +1,308 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/model"
+)
+
+// DefaultExemptBranches are the branches that are not checked by default.
+var DefaultExemptBranches = []string{"main", "master"}
+
+// BranchNameConfig provides configuration for the BranchName rule.
+type BranchNameConfig struct {
+	// Patterns lists regular expressions of valid branch names (empty allows all names)
+	Patterns []string
+
+	// Exempt lists glob patterns of branches that are not checked, e.g. "release/*"
+	Exempt []string
+
+	// RequireJiraKey requires a Jira key such as PROJ-123 in the branch name
+	RequireJiraKey bool
+
+	// CommitSubjects, if set, are checked to reference a Jira key of the branch name
+	CommitSubjects []string
+}
+
+// DefaultBranchNameConfig returns the default configuration.
+func DefaultBranchNameConfig() BranchNameConfig {
+	return BranchNameConfig{
+		Exempt: DefaultExemptBranches,
+	}
+}
+
+// BranchNameOption configures a BranchNameConfig.
+type BranchNameOption func(*BranchNameConfig)
+
+// WithBranchPatterns sets the regular expressions of valid branch names.
+func WithBranchPatterns(patterns []string) BranchNameOption {
+	return func(c *BranchNameConfig) {
+		c.Patterns = append(c.Patterns, patterns...)
+	}
+}
+
+// WithExemptBranches replaces the default exempt branches.
+func WithExemptBranches(patterns []string) BranchNameOption {
+	return func(c *BranchNameConfig) {
+		if len(patterns) > 0 {
+			c.Exempt = patterns
+		}
+	}
+}
+
+// WithBranchJiraKeyRequired sets whether the branch name must contain a Jira key.
+func WithBranchJiraKeyRequired(require bool) BranchNameOption {
+	return func(c *BranchNameConfig) {
+		c.RequireJiraKey = require
+	}
+}
+
+// WithCommitJiraKeys cross-checks the Jira keys of the commit subjects with the branch name.
+func WithCommitJiraKeys(subjects []string) BranchNameOption {
+	return func(c *BranchNameConfig) {
+		c.CommitSubjects = append(c.CommitSubjects, subjects...)
+	}
+}
+
+// BranchName enforces a branch naming convention.
+//
+// Branch names such as "feat/PROJ-123-short-desc" tell reviewers and tools what a branch
+// is for, and link it to an issue. The rule checks that the branch name:
+//
+//   - matches one of the configured regular expressions
+//   - contains a Jira key, if required
+//   - uses the same Jira key as the commit subjects that reference one, so that commits
+//     are not filed under another issue than their branch
+//
+// Exempt branches, by default main and master, are not checked. Commit subjects without
+// a Jira key are left to the JiraReference rule.
+//
+// Examples:
+//
+//   - With the pattern "^(feat|fix)/[A-Z]+-\d+-[a-z0-9-]+$":
+//     "feat/PROJ-123-short-desc" would pass
+//     "my-branch" would fail
+//   - With the Jira cross-check, branch "feat/PROJ-123-login" and the subject
+//     "feat: add login PROJ-456" would fail
+type BranchName struct {
+	branch         string
+	exempt         bool
+	keys           []string
+	invalidPattern string
+	errors         []*model.ValidationError
+}
+
+// Name returns the rule name.
+func (rule BranchName) Name() string {
+	return "BranchName"
+}
+
+// Result returns a concise validation result.
+func (rule BranchName) Result() string {
+	if len(rule.errors) > 0 {
+		return "Invalid branch name"
+	}
+
+	return "Valid branch name"
+}
+
+// VerboseResult returns a more detailed explanation for verbose mode.
+func (rule BranchName) VerboseResult() string {
+	if len(rule.errors) > 0 {
+		switch rule.errors[0].Code {
+		case "invalid_pattern":
+			return fmt.Sprintf("Branch pattern '%s' is not a valid regular expression.", rule.invalidPattern)
+		case "missing_branch":
+			return "No branch to validate: HEAD is detached and no CI branch variable is set"
+		case "invalid_branch_name":
+			return fmt.Sprintf("Branch '%s' does not match any of the patterns: %s",
+				rule.branch, rule.errors[0].Context["patterns"])
+		case "missing_branch_jira_key":
+			return fmt.Sprintf("Branch '%s' does not contain a Jira key such as PROJ-123", rule.branch)
+		case "jira_key_mismatch":
+			return fmt.Sprintf("Commit '%s' references %s, but branch '%s' is for %s",
+				rule.errors[0].Context["subject"], rule.errors[0].Context["commit_keys"],
+				rule.branch, rule.errors[0].Context["branch_keys"])
+		default:
+			return rule.errors[0].Error()
+		}
+	}
+
+	if rule.exempt {
+		return fmt.Sprintf("Branch '%s' is exempt from the naming convention", rule.branch)
+	}
+
+	if len(rule.keys) > 0 {
+		return fmt.Sprintf("Branch '%s' follows the naming convention for %s", rule.branch, strings.Join(rule.keys, ", "))
+	}
+
+	return fmt.Sprintf("Branch '%s' follows the naming convention", rule.branch)
+}
+
+// addError adds a structured validation error.
+func (rule *BranchName) addError(code, message string, context map[string]string) {
+	err := model.NewValidationError("BranchName", code, message)
+
+	// Add any context values
+	for key, value := range context {
+		_ = err.WithContext(key, value)
+	}
+
+	rule.errors = append(rule.errors, err)
+}
+
+// Errors returns validation errors.
+func (rule BranchName) Errors() []*model.ValidationError {
+	return rule.errors
+}
+
+// Help returns a description of how to fix the rule violation.
+func (rule BranchName) Help() string {
+	if len(rule.errors) == 0 {
+		return "No errors to fix"
+	}
+
+	switch rule.errors[0].Code {
+	case "invalid_pattern":
+		return "Fix the 'branch.patterns' setting so that every entry is a valid Go regular expression"
+
+	case "missing_branch":
+		return `Check out a branch, or name the branch to validate:
+
+  gommitlint validate --branch=feat/PROJ-123-short-desc`
+
+	case "invalid_branch_name":
+		return fmt.Sprintf(`Rename the branch to match one of the patterns: %s
+
+  git branch -m <new-name>
+
+If the branch was pushed, push it under the new name and delete the old one.`,
+			rule.errors[0].Context["patterns"])
+
+	case "missing_branch_jira_key":
+		return `Include the Jira key of the issue in the branch name, e.g.:
+
+  git branch -m feat/PROJ-123-short-desc`
+
+	case "jira_key_mismatch":
+		return fmt.Sprintf(`The commits of this branch must reference the Jira key of the branch: %s.
+Reword the commit subject with 'git rebase -i', or move the commit to a branch
+for the other issue.`, rule.errors[0].Context["branch_keys"])
+	}
+
+	return rule.errors[0].Message
+}
+
+// ValidateBranchName checks a branch name against the naming convention.
+//
+// Parameters:
+//   - branch: The short branch name, e.g. "feat/PROJ-123-login"; empty for a detached HEAD
+//   - opts: Options overriding DefaultBranchNameConfig
+//
+// Returns:
+//   - A BranchName instance with validation results
+func ValidateBranchName(branch string, opts ...BranchNameOption) *BranchName {
+	config := DefaultBranchNameConfig()
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	rule := &BranchName{branch: branch}
+
+	patternRegexes := make([]*regexp.Regexp, 0, len(config.Patterns))
+
+	for _, pattern := range config.Patterns {
+		patternRegex, err := regexp.Compile(pattern)
+		if err != nil {
+			rule.invalidPattern = pattern
+			rule.addError(
+				"invalid_pattern",
+				fmt.Sprintf("invalid branch pattern %q: %s", pattern, err),
+				map[string]string{
+					"pattern": pattern,
+					"error":   err.Error(),
+				},
+			)
+
+			return rule
+		}
+
+		patternRegexes = append(patternRegexes, patternRegex)
+	}
+
+	if branch == "" {
+		rule.addError(
+			"missing_branch",
+			"no branch to validate",
+			nil,
+		)
+
+		return rule
+	}
+
+	rule.exempt = slices.ContainsFunc(config.Exempt, func(pattern string) bool {
+		matched, _ := path.Match(pattern, branch)
+
+		return matched
+	})
+	if rule.exempt {
+		return rule
+	}
+
+	if len(patternRegexes) > 0 && !matchesAny(patternRegexes, branch) {
+		rule.addError(
+			"invalid_branch_name",
+			fmt.Sprintf("branch %q does not match the naming convention", branch),
+			map[string]string{
+				"branch":   branch,
+				"patterns": strings.Join(config.Patterns, ", "),
+			},
+		)
+	}
+
+	rule.keys = jiraKeyRegex.FindAllString(branch, -1)
+
+	if len(rule.keys) == 0 {
+		if config.RequireJiraKey {
+			rule.addError(
+				"missing_branch_jira_key",
+				fmt.Sprintf("branch %q does not contain a Jira key", branch),
+				map[string]string{
+					"branch": branch,
+				},
+			)
+		}
+
+		return rule
+	}
+
+	for _, subject := range config.CommitSubjects {
+		commitKeys := jiraKeyRegex.FindAllString(subject, -1)
+		if len(commitKeys) == 0 {
+			continue
+		}
+
+		if !slices.ContainsFunc(commitKeys, func(key string) bool { return slices.Contains(rule.keys, key) }) {
+			rule.addError(
+				"jira_key_mismatch",
+				fmt.Sprintf("commit %q references %s instead of %s",
+					subject, strings.Join(commitKeys, ", "), strings.Join(rule.keys, ", ")),
+				map[string]string{
+					"subject":     subject,
+					"commit_keys": strings.Join(commitKeys, ", "),
+					"branch_keys": strings.Join(rule.keys, ", "),
+				},
+			)
+		}
+	}
+
+	return rule
+}
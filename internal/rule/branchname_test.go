@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/rule"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateBranchName(t *testing.T) {
+	convention := rule.WithBranchPatterns([]string{`^(feat|fix|chore)/[A-Z]+-\d+-[a-z0-9-]+$`})
+
+	tests := []struct {
+		name      string
+		branch    string
+		opts      []rule.BranchNameOption
+		wantCodes []string
+	}{
+		{
+			name:   "no convention",
+			branch: "anything goes",
+		},
+		{
+			name:   "matches convention",
+			branch: "feat/PROJ-123-short-desc",
+			opts:   []rule.BranchNameOption{convention},
+		},
+		{
+			name:      "does not match convention",
+			branch:    "my-branch",
+			opts:      []rule.BranchNameOption{convention},
+			wantCodes: []string{"invalid_branch_name"},
+		},
+		{
+			name:   "default exempt branch",
+			branch: "main",
+			opts:   []rule.BranchNameOption{convention, rule.WithBranchJiraKeyRequired(true)},
+		},
+		{
+			name:   "custom exempt branches",
+			branch: "release/1.2",
+			opts:   []rule.BranchNameOption{convention, rule.WithExemptBranches([]string{"main", "release/*"})},
+		},
+		{
+			name:      "custom exempt branches replace the defaults",
+			branch:    "master",
+			opts:      []rule.BranchNameOption{convention, rule.WithExemptBranches([]string{"main"})},
+			wantCodes: []string{"invalid_branch_name"},
+		},
+		{
+			name:      "detached HEAD",
+			branch:    "",
+			wantCodes: []string{"missing_branch"},
+		},
+		{
+			name:      "invalid pattern",
+			branch:    "feat/PROJ-1",
+			opts:      []rule.BranchNameOption{rule.WithBranchPatterns([]string{"feat/("})},
+			wantCodes: []string{"invalid_pattern"},
+		},
+		{
+			name:      "missing Jira key",
+			branch:    "feat/short-desc",
+			opts:      []rule.BranchNameOption{rule.WithBranchJiraKeyRequired(true)},
+			wantCodes: []string{"missing_branch_jira_key"},
+		},
+		{
+			name:   "commit keys match",
+			branch: "feat/PROJ-123-login",
+			opts: []rule.BranchNameOption{rule.WithCommitJiraKeys([]string{
+				"feat: add login PROJ-123",
+				"fix: typo",
+				"test: cover login [PROJ-123, PROJ-124]",
+			})},
+		},
+		{
+			name:   "commit key differs",
+			branch: "feat/PROJ-123-login",
+			opts: []rule.BranchNameOption{rule.WithCommitJiraKeys([]string{
+				"feat: add login PROJ-123",
+				"fix: unrelated bug OPS-9",
+			})},
+			wantCodes: []string{"jira_key_mismatch"},
+		},
+		{
+			name:   "branch without key skips the cross-check",
+			branch: "feat/login",
+			opts:   []rule.BranchNameOption{rule.WithCommitJiraKeys([]string{"fix: unrelated bug OPS-9"})},
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			result := rule.ValidateBranchName(tabletest.branch, tabletest.opts...)
+
+			codes := make([]string, 0, len(result.Errors()))
+			for _, err := range result.Errors() {
+				codes = append(codes, err.Code)
+			}
+
+			if len(tabletest.wantCodes) == 0 {
+				require.Empty(t, codes)
+				require.Equal(t, "Valid branch name", result.Result())
+				require.Equal(t, "No errors to fix", result.Help())
+
+				return
+			}
+
+			require.Equal(t, tabletest.wantCodes, codes)
+			require.Equal(t, "Invalid branch name", result.Result())
+			require.NotEmpty(t, result.VerboseResult())
+		})
+	}
+}
+
+func TestBranchNameVerboseResult(t *testing.T) {
+	result := rule.ValidateBranchName("feat/PROJ-123-login", rule.WithCommitJiraKeys([]string{"fix: bug OPS-9"}))
+	require.Equal(t, "Commit 'fix: bug OPS-9' references OPS-9, but branch 'feat/PROJ-123-login' is for PROJ-123", result.VerboseResult())
+
+	result = rule.ValidateBranchName("main")
+	require.Equal(t, "Branch 'main' is exempt from the naming convention", result.VerboseResult())
+}
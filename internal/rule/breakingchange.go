@@ -0,0 +1,279 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/itiquette/gommitlint/internal/model"
+)
+
+// BreakingChangeConfig provides configuration for the BreakingChange rule.
+type BreakingChangeConfig struct {
+	// RequireFooter requires a BREAKING CHANGE footer on commits marked with "!"
+	RequireFooter bool
+
+	// RequireMarker requires the "!" marker on commits with a BREAKING CHANGE footer
+	RequireMarker bool
+
+	// AllowedTypes lists the commit types that may introduce breaking changes (empty allows all)
+	AllowedTypes []string
+
+	// MinFooterLength is the minimum length of the footer description (0 disables the check)
+	MinFooterLength int
+
+	// Message is the parsed message; subject and body are parsed if it is nil
+	Message *model.ConventionalCommit
+}
+
+// BreakingChangeOption configures a BreakingChangeConfig.
+type BreakingChangeOption func(*BreakingChangeConfig)
+
+// WithBreakingFooterRequired sets whether "!" commits need a BREAKING CHANGE footer.
+func WithBreakingFooterRequired(require bool) BreakingChangeOption {
+	return func(c *BreakingChangeConfig) {
+		c.RequireFooter = require
+	}
+}
+
+// WithBreakingMarkerRequired sets whether commits with a BREAKING CHANGE footer need the "!" marker.
+func WithBreakingMarkerRequired(require bool) BreakingChangeOption {
+	return func(c *BreakingChangeConfig) {
+		c.RequireMarker = require
+	}
+}
+
+// WithBreakingTypes restricts breaking changes to the given commit types.
+func WithBreakingTypes(types []string) BreakingChangeOption {
+	return func(c *BreakingChangeConfig) {
+		c.AllowedTypes = append(c.AllowedTypes, types...)
+	}
+}
+
+// WithBreakingChangeMessage uses the already parsed message instead of parsing subject and body.
+func WithBreakingChangeMessage(message *model.ConventionalCommit) BreakingChangeOption {
+	return func(c *BreakingChangeConfig) {
+		c.Message = message
+	}
+}
+
+// WithMinBreakingFooterLength sets the minimum length of the BREAKING CHANGE description.
+func WithMinBreakingFooterLength(minLength int) BreakingChangeOption {
+	return func(c *BreakingChangeConfig) {
+		c.MinFooterLength = minLength
+	}
+}
+
+// BreakingChange checks that breaking changes in conventional commits are declared consistently.
+//
+// The Conventional Commits specification offers two ways to mark a breaking change: a "!"
+// after the type or scope, and a "BREAKING CHANGE:" (or "BREAKING-CHANGE:") footer. Release
+// tools rely on either, and the footer is where users learn what breaks and how to migrate.
+// Depending on the configuration this rule requires:
+//
+//   - a BREAKING CHANGE footer on every commit marked with "!"
+//   - the "!" marker on every commit with a BREAKING CHANGE footer
+//   - breaking changes to use one of the allowed types, e.g. only "feat" and "refactor"
+//   - a footer description of a minimum length
+//
+// Subjects that are not conventional commits are left to the ConventionalCommit rule.
+//
+// Examples:
+//
+//   - With the footer required:
+//     "feat!: drop v1 API" with "BREAKING CHANGE: the /v1 endpoints are removed" would pass
+//     "feat!: drop v1 API" without a footer would fail
+//   - With the marker required:
+//     "feat: drop v1 API" with a BREAKING CHANGE footer would fail
+type BreakingChange struct {
+	commitType string
+	hasMarker  bool
+	footer     string
+	hasFooter  bool
+	errors     []*model.ValidationError
+}
+
+// Name returns the rule name.
+func (rule BreakingChange) Name() string {
+	return "BreakingChange"
+}
+
+// Result returns a concise validation result.
+func (rule BreakingChange) Result() string {
+	if len(rule.errors) > 0 {
+		return "Inconsistent breaking change"
+	}
+
+	return "Breaking change consistent"
+}
+
+// VerboseResult returns a more detailed explanation for verbose mode.
+func (rule BreakingChange) VerboseResult() string {
+	if len(rule.errors) > 0 {
+		switch rule.errors[0].Code {
+		case "missing_breaking_footer":
+			return "Commit is marked as breaking with '!' but has no 'BREAKING CHANGE:' footer describing the change"
+		case "missing_breaking_marker":
+			return "Commit has a 'BREAKING CHANGE:' footer but its subject is not marked with '!'"
+		case "breaking_type_not_allowed":
+			return fmt.Sprintf("Type '%s' may not introduce breaking changes. Allowed types: %s",
+				rule.commitType, strings.ReplaceAll(rule.errors[0].Context["allowed_types"], ",", ", "))
+		case "breaking_footer_too_short":
+			return fmt.Sprintf("'BREAKING CHANGE:' description has %s characters, at least %s are required",
+				rule.errors[0].Context["actual_length"], rule.errors[0].Context["min_length"])
+		default:
+			return rule.errors[0].Error()
+		}
+	}
+
+	if rule.hasFooter {
+		return "Breaking change is declared consistently: " + rule.footer
+	}
+
+	if rule.hasMarker {
+		return "Breaking change is marked with '!'"
+	}
+
+	return "Commit does not declare a breaking change"
+}
+
+// addError adds a structured validation error.
+func (rule *BreakingChange) addError(code, message string, context map[string]string) {
+	err := model.NewValidationError("BreakingChange", code, message)
+
+	// Add any context values
+	for key, value := range context {
+		_ = err.WithContext(key, value)
+	}
+
+	rule.errors = append(rule.errors, err)
+}
+
+// Errors returns validation errors.
+func (rule BreakingChange) Errors() []*model.ValidationError {
+	return rule.errors
+}
+
+// Help returns a description of how to fix the rule violation.
+func (rule BreakingChange) Help() string {
+	if len(rule.errors) == 0 {
+		return "No errors to fix"
+	}
+
+	switch rule.errors[0].Code {
+	case "missing_breaking_footer":
+		return `Describe the breaking change in a footer at the end of the commit message:
+
+feat!: drop the v1 API
+
+BREAKING CHANGE: the /v1 endpoints are removed, use /v2 instead.
+
+Explain what breaks and how users migrate.`
+
+	case "missing_breaking_marker":
+		return `Mark the breaking change in the subject with '!' after the type or scope:
+
+feat(api)!: drop the v1 API
+
+If the change is not breaking, remove the 'BREAKING CHANGE:' footer.`
+
+	case "breaking_type_not_allowed":
+		return fmt.Sprintf(`Breaking changes may only be introduced with these types: %s.
+Use one of them, or remove the breaking change marker and footer if the change is not breaking.`,
+			strings.ReplaceAll(rule.errors[0].Context["allowed_types"], ",", ", "))
+
+	case "breaking_footer_too_short":
+		return fmt.Sprintf(`Describe the breaking change in at least %s characters.
+Explain what breaks and how users migrate.`, rule.errors[0].Context["min_length"])
+	}
+
+	return rule.errors[0].Message
+}
+
+// ValidateBreakingChange checks that the "!" marker and the BREAKING CHANGE footer of a
+// conventional commit are consistent.
+//
+// Parameters:
+//   - subject: The commit subject line
+//   - body: The commit body, which holds the footers
+//   - opts: Options enabling the individual checks
+//
+// Returns:
+//   - A BreakingChange instance with validation results
+func ValidateBreakingChange(subject, body string, opts ...BreakingChangeOption) *BreakingChange {
+	var config BreakingChangeConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	commit := config.Message
+	if commit == nil {
+		commit = model.ParseConventionalCommit(subject + "\n\n" + body)
+	}
+
+	rule := &BreakingChange{}
+	if commit == nil {
+		return rule
+	}
+
+	rule.commitType = commit.Type
+	rule.hasMarker = commit.BreakingMarker
+	rule.footer, rule.hasFooter = commit.BreakingDescription()
+
+	if rule.hasMarker && !rule.hasFooter && config.RequireFooter {
+		rule.addError(
+			"missing_breaking_footer",
+			"breaking change marked with '!' has no BREAKING CHANGE footer",
+			map[string]string{
+				"subject": subject,
+			},
+		)
+	}
+
+	if rule.hasFooter && !rule.hasMarker && config.RequireMarker {
+		rule.addError(
+			"missing_breaking_marker",
+			"BREAKING CHANGE footer without '!' in the subject",
+			map[string]string{
+				"subject": subject,
+			},
+		)
+	}
+
+	if !commit.Breaking {
+		return rule
+	}
+
+	if len(config.AllowedTypes) > 0 && !slices.Contains(config.AllowedTypes, rule.commitType) {
+		rule.addError(
+			"breaking_type_not_allowed",
+			fmt.Sprintf("type %q may not introduce breaking changes: allowed types are %s",
+				rule.commitType, strings.Join(config.AllowedTypes, ", ")),
+			map[string]string{
+				"type":          rule.commitType,
+				"allowed_types": strings.Join(config.AllowedTypes, ","),
+			},
+		)
+	}
+
+	footerLength := utf8.RuneCountInString(strings.ReplaceAll(rule.footer, "\n", " "))
+	if rule.hasFooter && config.MinFooterLength > 0 && footerLength < config.MinFooterLength {
+		rule.addError(
+			"breaking_footer_too_short",
+			fmt.Sprintf("BREAKING CHANGE description too short: %d characters (minimum: %d)",
+				footerLength, config.MinFooterLength),
+			map[string]string{
+				"actual_length": strconv.Itoa(footerLength),
+				"min_length":    strconv.Itoa(config.MinFooterLength),
+			},
+		)
+	}
+
+	return rule
+}
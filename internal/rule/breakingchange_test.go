@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/model"
+	"github.com/itiquette/gommitlint/internal/rule"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateBreakingChange(t *testing.T) {
+	requireBoth := []rule.BreakingChangeOption{
+		rule.WithBreakingFooterRequired(true),
+		rule.WithBreakingMarkerRequired(true),
+	}
+
+	tests := []struct {
+		name      string
+		subject   string
+		body      string
+		opts      []rule.BreakingChangeOption
+		wantCodes []string
+	}{
+		{
+			name:    "not a breaking change",
+			subject: "feat: add login",
+			opts:    requireBoth,
+		},
+		{
+			name:    "not a conventional commit",
+			subject: "Add login",
+			body:    "BREAKING CHANGE: sessions are reset",
+			opts:    requireBoth,
+		},
+		{
+			name:    "marker and footer",
+			subject: "feat!: drop v1 API",
+			body:    "BREAKING CHANGE: the /v1 endpoints are removed",
+			opts:    requireBoth,
+		},
+		{
+			name:    "hyphenated footer after body",
+			subject: "feat(api)!: drop v1 API",
+			body:    "Clients moved to v2 last year.\n\nBREAKING-CHANGE: the /v1 endpoints are removed\nRefs: #123",
+			opts:    requireBoth,
+		},
+		{
+			name:      "marker without footer",
+			subject:   "feat!: drop v1 API",
+			opts:      requireBoth,
+			wantCodes: []string{"missing_breaking_footer"},
+		},
+		{
+			name:    "marker without footer allowed by default",
+			subject: "feat!: drop v1 API",
+		},
+		{
+			name:      "footer without marker",
+			subject:   "feat: drop v1 API",
+			body:      "BREAKING CHANGE: the /v1 endpoints are removed",
+			opts:      requireBoth,
+			wantCodes: []string{"missing_breaking_marker"},
+		},
+		{
+			name:    "lowercase footer is not a breaking change footer",
+			subject: "feat: drop v1 API",
+			body:    "breaking change: none",
+			opts:    requireBoth,
+		},
+		{
+			name:      "type not allowed",
+			subject:   "docs!: restructure guide",
+			body:      "BREAKING CHANGE: links to old pages break",
+			opts:      []rule.BreakingChangeOption{rule.WithBreakingTypes([]string{"feat", "refactor"})},
+			wantCodes: []string{"breaking_type_not_allowed"},
+		},
+		{
+			name:    "type allowed",
+			subject: "refactor!: rename config keys",
+			body:    "BREAKING CHANGE: rename max_len to max-length",
+			opts:    []rule.BreakingChangeOption{rule.WithBreakingTypes([]string{"feat", "refactor"})},
+		},
+		{
+			name:      "footer too short",
+			subject:   "feat!: drop v1 API",
+			body:      "BREAKING CHANGE: yes",
+			opts:      []rule.BreakingChangeOption{rule.WithMinBreakingFooterLength(20)},
+			wantCodes: []string{"breaking_footer_too_short"},
+		},
+		{
+			name:    "multi-line footer counts as a whole",
+			subject: "feat!: drop v1 API",
+			body:    "BREAKING CHANGE: the /v1 endpoints\nare removed, use /v2.",
+			opts:    []rule.BreakingChangeOption{rule.WithMinBreakingFooterLength(30)},
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			result := rule.ValidateBreakingChange(tabletest.subject, tabletest.body, tabletest.opts...)
+
+			var codes []string
+			for _, err := range result.Errors() {
+				codes = append(codes, err.Code)
+			}
+
+			require.Equal(t, tabletest.wantCodes, codes)
+
+			if len(codes) == 0 {
+				require.Equal(t, "Breaking change consistent", result.Result())
+			} else {
+				require.NotEqual(t, "No errors to fix", result.Help())
+			}
+		})
+	}
+}
+
+func TestValidateBreakingChangeParsedMessage(t *testing.T) {
+	message := model.ParseConventionalCommit("feat!: drop v1 API\n\nBREAKING CHANGE: the /v1 endpoints are removed")
+
+	// The parsed message is used instead of the subject and body
+	result := rule.ValidateBreakingChange("", "", rule.WithBreakingChangeMessage(message),
+		rule.WithBreakingTypes([]string{"fix"}))
+	require.Len(t, result.Errors(), 1)
+	require.Equal(t, "breaking_type_not_allowed", result.Errors()[0].Code)
+}
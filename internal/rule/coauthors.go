@@ -0,0 +1,313 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/model"
+)
+
+// coAuthorAttemptRegex matches lines that look like an attempt at a Co-authored-by trailer,
+// including common misspellings such as "Co-Authored By:" or "Coauthored-by:". Prose
+// starting with "Co-authored by" is only matched if it contains a colon or an email.
+var coAuthorAttemptRegex = regexp.MustCompile(`(?i)^co[-_ ]?author(ed)?[-_ ]?by\b.*[:<@]`)
+
+// coAuthorKey is the trailer key that credits a co-author, compared case-insensitively.
+const coAuthorKey = "Co-authored-by"
+
+// coAuthorIdentityRegex matches the value of a Co-authored-by trailer, "Name <email>".
+var coAuthorIdentityRegex = regexp.MustCompile(`^([^<>\s][^<>]*?) <([^<>@\s]+@[^<>@\s]+\.[^<>@\s]+)>$`)
+
+// CoAuthorsConfig provides configuration for the CoAuthors rule.
+type CoAuthorsConfig struct {
+	// AllowedDomains lists email domains co-authors must use (empty allows all)
+	AllowedDomains []string
+
+	// Mailmap, if set, lists known identities; co-authors found in it are allowed
+	Mailmap *model.Mailmap
+
+	// Author, if set, must not be listed as co-author
+	Author *model.Identity
+}
+
+// CoAuthorsOption configures a CoAuthorsConfig.
+type CoAuthorsOption func(*CoAuthorsConfig)
+
+// WithAllowedCoAuthorDomains restricts co-author emails to the given domains and their subdomains.
+func WithAllowedCoAuthorDomains(domains []string) CoAuthorsOption {
+	return func(c *CoAuthorsConfig) {
+		c.AllowedDomains = append(c.AllowedDomains, domains...)
+	}
+}
+
+// WithCoAuthorMailmap allows co-authors listed in the mailmap.
+func WithCoAuthorMailmap(mailmap *model.Mailmap) CoAuthorsOption {
+	return func(c *CoAuthorsConfig) {
+		c.Mailmap = mailmap
+	}
+}
+
+// WithCoAuthorsCommitAuthor rejects the commit author as co-author.
+func WithCoAuthorsCommitAuthor(name, email string) CoAuthorsOption {
+	return func(c *CoAuthorsConfig) {
+		c.Author = &model.Identity{Name: name, Email: email}
+	}
+}
+
+// CoAuthors validates "Co-authored-by:" trailers, as used by GitHub and GitLab to credit
+// additional authors of a commit.
+//
+// Each co-author line must have the form "Co-authored-by: Name <email>" (the key is
+// case-insensitive, as on GitHub), and every co-author may only be listed once. Optionally co-author emails must belong to one of the
+// allowed domains or appear in a mailmap, so that commits credit work identities rather than
+// personal addresses. The commit author is never a co-author of their own commit.
+//
+// Every error carries the 1-based line number in the full commit message (the subject is
+// line 1).
+//
+// Examples:
+//
+//   - "Co-authored-by: Jane Doe <jane@example.com>" would pass
+//   - "Co-Authored By: Jane Doe <jane@example.com>" would fail (malformed key)
+//   - "Co-authored-by: jane@example.com" would fail (missing name and angle brackets)
+//   - The same co-author listed twice would fail
+type CoAuthors struct {
+	coAuthors []model.Identity
+	errors    []*model.ValidationError
+}
+
+// Name returns the rule name.
+func (rule CoAuthors) Name() string {
+	return "CoAuthors"
+}
+
+// Result returns a concise validation result.
+func (rule CoAuthors) Result() string {
+	if len(rule.errors) > 0 {
+		return "Invalid co-authors"
+	}
+
+	return "Co-authors OK"
+}
+
+// VerboseResult returns a more detailed explanation for verbose mode.
+func (rule CoAuthors) VerboseResult() string {
+	if len(rule.errors) > 0 {
+		problems := make([]string, 0, len(rule.errors))
+		for _, err := range rule.errors {
+			problems = append(problems, fmt.Sprintf("line %s: %s", err.Context["line_number"], err.Message))
+		}
+
+		return strings.Join(problems, "; ")
+	}
+
+	if len(rule.coAuthors) == 0 {
+		return "No co-authors listed"
+	}
+
+	names := make([]string, 0, len(rule.coAuthors))
+	for _, coAuthor := range rule.coAuthors {
+		names = append(names, coAuthor.String())
+	}
+
+	return "Co-authors: " + strings.Join(names, ", ")
+}
+
+// addError adds a structured validation error.
+func (rule *CoAuthors) addError(code, message string, context map[string]string) {
+	err := model.NewValidationError("CoAuthors", code, message)
+
+	// Add any context values
+	for key, value := range context {
+		_ = err.WithContext(key, value)
+	}
+
+	rule.errors = append(rule.errors, err)
+}
+
+// Errors returns validation errors.
+func (rule CoAuthors) Errors() []*model.ValidationError {
+	return rule.errors
+}
+
+// Help returns a description of how to fix the rule violation.
+func (rule CoAuthors) Help() string {
+	if len(rule.errors) == 0 {
+		return "No errors to fix"
+	}
+
+	switch rule.errors[0].Code {
+	case "invalid_coauthor_format":
+		return fmt.Sprintf(`Fix the co-author trailer on line %s. It must look exactly like:
+
+Co-authored-by: Jane Doe <jane@example.com>
+
+Use the key "Co-authored-by", a colon and a single space, the full name and the
+email address in angle brackets.`, rule.errors[0].Context["line_number"])
+
+	case "duplicate_coauthor":
+		return fmt.Sprintf("Remove the duplicate co-author on line %s; list every co-author only once.",
+			rule.errors[0].Context["line_number"])
+
+	case "coauthor_not_allowed":
+		return fmt.Sprintf(`The co-author on line %s uses an email address that is not allowed.
+Credit co-authors with their work email address, or add them to the project's .mailmap.`,
+			rule.errors[0].Context["line_number"])
+
+	case "coauthor_is_author":
+		return fmt.Sprintf(`Remove line %s. The commit author is already credited as author and
+must not be listed as co-author as well.`, rule.errors[0].Context["line_number"])
+
+	default:
+		return rule.errors[0].Message
+	}
+}
+
+// ValidateCoAuthors checks the Co-authored-by trailers of a commit message.
+//
+// Parameters:
+//   - message: The full commit message, including the subject line
+//   - opts: Options enabling the allowlist and author checks
+//
+// Returns:
+//   - A CoAuthors instance with one error per offending line
+func ValidateCoAuthors(message string, opts ...CoAuthorsOption) *CoAuthors {
+	var config CoAuthorsConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	rule := &CoAuthors{}
+
+	seen := make(map[string]int)
+
+	trailers := make(map[int]model.Trailer)
+	for _, trailer := range coAuthorTrailers(model.ParseTrailers(message)) {
+		trailers[trailer.Line] = trailer
+	}
+
+	// Line 1 is the subject, which never holds a trailer. Lines that are not trailers are
+	// still checked, so that misspelled keys such as "Co-Authored By:" are reported.
+	lines := strings.Split(message, "\n")
+	for index := 1; index < len(lines); index++ {
+		line := strings.TrimSpace(lines[index])
+		if strings.HasPrefix(line, "# -") && strings.Contains(line, ">8") {
+			break // "git commit --verbose" diff follows
+		}
+
+		if !coAuthorAttemptRegex.MatchString(line) {
+			continue
+		}
+
+		lineNumber := strconv.Itoa(index + 1)
+
+		trailer, isTrailer := trailers[index+1]
+		coAuthor, valid := parseCoAuthor(trailer.Value)
+
+		// The key is case-insensitive, but must be followed by exactly ": "
+		if !isTrailer || !valid || line != trailer.Key+": "+trailer.Value {
+			rule.addError(
+				"invalid_coauthor_format",
+				"malformed co-author trailer, expected 'Co-authored-by: Name <email>'",
+				map[string]string{
+					"line_number": lineNumber,
+					"line":        line,
+				},
+			)
+
+			continue
+		}
+
+		email := strings.ToLower(coAuthor.Email)
+
+		if first, ok := seen[email]; ok {
+			rule.addError(
+				"duplicate_coauthor",
+				fmt.Sprintf("co-author %s is already listed on line %d", coAuthor, first),
+				map[string]string{
+					"line_number": lineNumber,
+					"email":       coAuthor.Email,
+					"first_line":  strconv.Itoa(first),
+				},
+			)
+
+			continue
+		}
+
+		seen[email] = index + 1
+		rule.coAuthors = append(rule.coAuthors, coAuthor)
+
+		if config.Author != nil && strings.EqualFold(config.Author.Email, coAuthor.Email) {
+			rule.addError(
+				"coauthor_is_author",
+				fmt.Sprintf("commit author %s is listed as co-author", coAuthor),
+				map[string]string{
+					"line_number": lineNumber,
+					"email":       coAuthor.Email,
+				},
+			)
+
+			continue
+		}
+
+		if !config.allows(coAuthor.Email) {
+			rule.addError(
+				"coauthor_not_allowed",
+				fmt.Sprintf("co-author email %s is not in an allowed domain or the mailmap", coAuthor.Email),
+				map[string]string{
+					"line_number":     lineNumber,
+					"email":           coAuthor.Email,
+					"allowed_domains": strings.Join(config.AllowedDomains, ", "),
+				},
+			)
+		}
+	}
+
+	return rule
+}
+
+// allows reports whether the email passes the domain and mailmap allowlist.
+// Without an allowlist every email is allowed.
+func (c CoAuthorsConfig) allows(email string) bool {
+	if len(c.AllowedDomains) == 0 && c.Mailmap == nil {
+		return true
+	}
+
+	if c.Mailmap.Contains(email) {
+		return true
+	}
+
+	return hasAllowedDomain(email, c.AllowedDomains)
+}
+
+// coAuthorTrailers returns the Co-authored-by trailers of the trailer block and of the
+// body before it, in message order.
+func coAuthorTrailers(trailers model.Trailers) []model.Trailer {
+	var coAuthors []model.Trailer
+
+	for _, trailer := range append(slices.Clone(trailers.Outside), trailers.Block...) {
+		if strings.EqualFold(trailer.Key, coAuthorKey) {
+			coAuthors = append(coAuthors, trailer)
+		}
+	}
+
+	return coAuthors
+}
+
+// parseCoAuthor returns the identity in the value of a Co-authored-by trailer, and false
+// if the value is not of the form "Name <email>".
+func parseCoAuthor(value string) (model.Identity, bool) {
+	match := coAuthorIdentityRegex.FindStringSubmatch(value)
+	if match == nil {
+		return model.Identity{}, false
+	}
+
+	return model.Identity{Name: strings.TrimSpace(match[1]), Email: match[2]}, true
+}
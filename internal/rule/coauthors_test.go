@@ -0,0 +1,133 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/model"
+	"github.com/itiquette/gommitlint/internal/rule"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCoAuthors(t *testing.T) {
+	mailmap, err := model.ParseMailmap("Jane Doe <jane@example.com> <jane.doe@gmail.com>\n")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		message   string
+		opts      []rule.CoAuthorsOption
+		wantCodes []string
+		wantLines []string
+	}{
+		{
+			name:    "no co-authors",
+			message: "Add feature\n\nImplement it.",
+		},
+		{
+			name:    "valid co-authors",
+			message: "Add feature\n\nImplement it.\n\nCo-authored-by: Jane Doe <jane@example.com>\nCo-Authored-By: John Smith <john@example.com>",
+		},
+		{
+			name:      "malformed key",
+			message:   "Add feature\n\nCo-Authored By: Jane Doe <jane@example.com>",
+			wantCodes: []string{"invalid_coauthor_format"},
+			wantLines: []string{"3"},
+		},
+		{
+			name:      "missing name",
+			message:   "Add feature\n\nCo-authored-by: <jane@example.com>",
+			wantCodes: []string{"invalid_coauthor_format"},
+			wantLines: []string{"3"},
+		},
+		{
+			name:      "missing angle brackets",
+			message:   "Add feature\n\nImplement it.\n\nCo-authored-by: jane@example.com",
+			wantCodes: []string{"invalid_coauthor_format"},
+			wantLines: []string{"5"},
+		},
+		{
+			name:      "space before colon",
+			message:   "Add feature\n\nCo-authored-by : Jane Doe <jane@example.com>",
+			wantCodes: []string{"invalid_coauthor_format"},
+			wantLines: []string{"3"},
+		},
+		{
+			name:    "co-author followed by sign-off",
+			message: "Add feature\n\nCo-authored-by: Jane Doe <jane@example.com>\nSigned-off-by: John Smith <john@example.com>",
+		},
+		{
+			name:    "prose is not a trailer",
+			message: "Add feature\n\nCo-authored by the platform team during the offsite.",
+		},
+		{
+			name:      "duplicate co-author",
+			message:   "Add feature\n\nCo-authored-by: Jane Doe <jane@example.com>\nCo-authored-by: Jane D <JANE@example.com>",
+			wantCodes: []string{"duplicate_coauthor"},
+			wantLines: []string{"4"},
+		},
+		{
+			name:      "author listed as co-author",
+			message:   "Add feature\n\nCo-authored-by: Jane Doe <jane@example.com>",
+			opts:      []rule.CoAuthorsOption{rule.WithCoAuthorsCommitAuthor("Jane Doe", "jane@example.com")},
+			wantCodes: []string{"coauthor_is_author"},
+			wantLines: []string{"3"},
+		},
+		{
+			name:    "allowed domain and subdomain",
+			message: "Add feature\n\nCo-authored-by: Jane Doe <jane@example.com>\nCo-authored-by: John Smith <john@eu.example.com>",
+			opts:    []rule.CoAuthorsOption{rule.WithAllowedCoAuthorDomains([]string{"example.com"})},
+		},
+		{
+			name:      "personal email rejected",
+			message:   "Add feature\n\nCo-authored-by: Jane Doe <jane@example.com>\nCo-authored-by: John Smith <john@gmail.com>",
+			opts:      []rule.CoAuthorsOption{rule.WithAllowedCoAuthorDomains([]string{"example.com"})},
+			wantCodes: []string{"coauthor_not_allowed"},
+			wantLines: []string{"4"},
+		},
+		{
+			name:    "mailmap entry allowed",
+			message: "Add feature\n\nCo-authored-by: Jane Doe <jane.doe@gmail.com>",
+			opts: []rule.CoAuthorsOption{
+				rule.WithAllowedCoAuthorDomains([]string{"example.com"}),
+				rule.WithCoAuthorMailmap(mailmap),
+			},
+		},
+		{
+			name:      "not in mailmap",
+			message:   "Add feature\n\nCo-authored-by: John Smith <john@gmail.com>",
+			opts:      []rule.CoAuthorsOption{rule.WithCoAuthorMailmap(mailmap)},
+			wantCodes: []string{"coauthor_not_allowed"},
+			wantLines: []string{"3"},
+		},
+		{
+			name:    "diff after scissors is ignored",
+			message: "Add feature\n\n# ------------------------ >8 ------------------------\nCo-authored-by: broken",
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			result := rule.ValidateCoAuthors(tabletest.message, tabletest.opts...)
+
+			var codes, lines []string
+			for _, err := range result.Errors() {
+				codes = append(codes, err.Code)
+				lines = append(lines, err.Context["line_number"])
+			}
+
+			require.Equal(t, tabletest.wantCodes, codes)
+			require.Equal(t, tabletest.wantLines, lines)
+			require.Equal(t, "CoAuthors", result.Name())
+
+			if len(codes) > 0 {
+				require.Contains(t, result.Help(), "line "+lines[0])
+			} else {
+				require.Equal(t, "No errors to fix", result.Help())
+			}
+		})
+	}
+}
@@ -0,0 +1,303 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/model"
+)
+
+// CommitIdentityConfig provides configuration for the CommitIdentity rule.
+type CommitIdentityConfig struct {
+	// AllowedDomains lists email domains, including subdomains, identities must use
+	AllowedDomains []string
+
+	// AllowedPatterns lists regular expressions of allowed email addresses
+	AllowedPatterns []string
+
+	// RequireFullName rejects names consisting of a single word
+	RequireFullName bool
+
+	// ForbidNoreply rejects noreply addresses such as 123+jane@users.noreply.github.com
+	ForbidNoreply bool
+
+	// RequireSameCommitter requires the committer to be the author for non-merge commits
+	RequireSameCommitter bool
+
+	// Mailmap, if set, canonicalises identities before they are checked
+	Mailmap *model.Mailmap
+}
+
+// CommitIdentityOption configures a CommitIdentityConfig.
+type CommitIdentityOption func(*CommitIdentityConfig)
+
+// WithAllowedIdentityDomains restricts emails to the given domains and their subdomains.
+func WithAllowedIdentityDomains(domains []string) CommitIdentityOption {
+	return func(c *CommitIdentityConfig) {
+		c.AllowedDomains = append(c.AllowedDomains, domains...)
+	}
+}
+
+// WithAllowedIdentityPatterns restricts emails to those matching one of the regular expressions.
+func WithAllowedIdentityPatterns(patterns []string) CommitIdentityOption {
+	return func(c *CommitIdentityConfig) {
+		c.AllowedPatterns = append(c.AllowedPatterns, patterns...)
+	}
+}
+
+// WithFullNameRequired sets whether names must consist of at least two words.
+func WithFullNameRequired(require bool) CommitIdentityOption {
+	return func(c *CommitIdentityConfig) {
+		c.RequireFullName = require
+	}
+}
+
+// WithNoreplyForbidden sets whether noreply addresses are rejected.
+func WithNoreplyForbidden(forbid bool) CommitIdentityOption {
+	return func(c *CommitIdentityConfig) {
+		c.ForbidNoreply = forbid
+	}
+}
+
+// WithSameCommitterRequired sets whether the committer must be the author of non-merge commits.
+func WithSameCommitterRequired(require bool) CommitIdentityOption {
+	return func(c *CommitIdentityConfig) {
+		c.RequireSameCommitter = require
+	}
+}
+
+// WithIdentityMailmap canonicalises identities with the mailmap.
+func WithIdentityMailmap(mailmap *model.Mailmap) CommitIdentityOption {
+	return func(c *CommitIdentityConfig) {
+		c.Mailmap = mailmap
+	}
+}
+
+// CommitIdentity enforces a policy on the author and committer recorded in a commit.
+//
+// Misconfigured machines produce commits by "root <root@localhost>", and personal
+// addresses end up in corporate history. This rule catches them before they are merged:
+//
+//   - emails can be restricted to allowed domains and/or regular expressions
+//   - names can be required to be full names rather than a single word
+//   - noreply addresses, which cannot be contacted, can be forbidden
+//   - the committer can be required to be the author, except for merge commits
+//
+// If a mailmap is configured, identities are canonicalised with it first, the same way
+// "git log --use-mailmap" shows them, so old addresses mapped to a work address pass.
+//
+// Examples:
+//
+//   - With allowed domain "example.com":
+//     "Jane Doe <jane@example.com>" would pass
+//     "root <root@localhost>" would fail
+//   - With full names required:
+//     "jane <jane@example.com>" would fail
+type CommitIdentity struct {
+	author         model.Identity
+	invalidPattern string
+	errors         []*model.ValidationError
+}
+
+// Name returns the rule name.
+func (rule CommitIdentity) Name() string {
+	return "CommitIdentity"
+}
+
+// Result returns a concise validation result.
+func (rule CommitIdentity) Result() string {
+	if len(rule.errors) > 0 {
+		return "Invalid commit identity"
+	}
+
+	return "Commit identity OK"
+}
+
+// VerboseResult returns a more detailed explanation for verbose mode.
+func (rule CommitIdentity) VerboseResult() string {
+	if len(rule.errors) > 0 {
+		switch rule.errors[0].Code {
+		case "invalid_pattern":
+			return fmt.Sprintf("Email pattern '%s' is not a valid regular expression.", rule.invalidPattern)
+		}
+
+		problems := make([]string, 0, len(rule.errors))
+		for _, err := range rule.errors {
+			problems = append(problems, err.Message)
+		}
+
+		return strings.Join(problems, "; ")
+	}
+
+	return "Commit identity " + rule.author.String() + " complies with the identity policy"
+}
+
+// addError adds a structured validation error.
+func (rule *CommitIdentity) addError(code, message string, context map[string]string) {
+	err := model.NewValidationError("CommitIdentity", code, message)
+
+	// Add any context values
+	for key, value := range context {
+		_ = err.WithContext(key, value)
+	}
+
+	rule.errors = append(rule.errors, err)
+}
+
+// Errors returns validation errors.
+func (rule CommitIdentity) Errors() []*model.ValidationError {
+	return rule.errors
+}
+
+// Help returns a description of how to fix the rule violation.
+func (rule CommitIdentity) Help() string {
+	if len(rule.errors) == 0 {
+		return "No errors to fix"
+	}
+
+	switch rule.errors[0].Code {
+	case "invalid_pattern":
+		return "Fix the 'commit-identity.allowed-patterns' setting so that every entry is a valid Go regular expression"
+
+	case "author_committer_mismatch":
+		return `The commit was committed by someone other than its author, which this
+repository only allows for merge commits. This happens when rebasing or
+cherry-picking someone else's commits. Ask the author to push the commit
+themselves, or reset the author with 'git commit --amend --reset-author'
+if the change is yours.`
+	}
+
+	return `Configure git with your full name and work email address:
+
+  git config user.name "Jane Doe"
+  git config user.email "jane@example.com"
+
+Then fix the existing commit with 'git commit --amend --reset-author'.
+If you have used another address before, map it to your work address in .mailmap.`
+}
+
+// ValidateCommitIdentity checks the author and committer of a commit against the identity policy.
+//
+// Parameters:
+//   - author: The author recorded in the commit
+//   - committer: The committer recorded in the commit
+//   - isMergeCommit: Whether the commit is a merge commit
+//   - opts: Options enabling the individual checks
+//
+// Returns:
+//   - A CommitIdentity instance with validation results
+func ValidateCommitIdentity(author, committer model.Identity, isMergeCommit bool, opts ...CommitIdentityOption) *CommitIdentity {
+	var config CommitIdentityConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	rule := &CommitIdentity{}
+
+	allowedRegexes := make([]*regexp.Regexp, 0, len(config.AllowedPatterns))
+
+	for _, pattern := range config.AllowedPatterns {
+		allowedRegex, err := regexp.Compile(pattern)
+		if err != nil {
+			rule.invalidPattern = pattern
+			rule.addError(
+				"invalid_pattern",
+				fmt.Sprintf("invalid email pattern %q: %s", pattern, err),
+				map[string]string{
+					"pattern": pattern,
+					"error":   err.Error(),
+				},
+			)
+
+			return rule
+		}
+
+		allowedRegexes = append(allowedRegexes, allowedRegex)
+	}
+
+	author.Name, author.Email = config.Mailmap.Resolve(author.Name, author.Email)
+	committer.Name, committer.Email = config.Mailmap.Resolve(committer.Name, committer.Email)
+	rule.author = author
+
+	rule.checkIdentity("author", author, config, allowedRegexes)
+
+	if !strings.EqualFold(author.Email, committer.Email) || author.Name != committer.Name {
+		rule.checkIdentity("committer", committer, config, allowedRegexes)
+
+		if config.RequireSameCommitter && !isMergeCommit && !strings.EqualFold(author.Email, committer.Email) {
+			rule.addError(
+				"author_committer_mismatch",
+				fmt.Sprintf("committer %s is not the author %s", committer, author),
+				map[string]string{
+					"author":    author.String(),
+					"committer": committer.String(),
+				},
+			)
+		}
+	}
+
+	return rule
+}
+
+// checkIdentity applies the name and email checks to one identity.
+func (rule *CommitIdentity) checkIdentity(role string, identity model.Identity, config CommitIdentityConfig, allowedRegexes []*regexp.Regexp) {
+	context := map[string]string{
+		"role":  role,
+		"name":  identity.Name,
+		"email": identity.Email,
+	}
+
+	if config.RequireFullName && len(strings.Fields(identity.Name)) < 2 {
+		rule.addError(
+			"name_not_full",
+			fmt.Sprintf("%s name %q is not a full name", role, identity.Name),
+			context,
+		)
+	}
+
+	if config.ForbidNoreply && isNoreplyEmail(identity.Email) {
+		rule.addError(
+			"noreply_email",
+			fmt.Sprintf("%s email %s is a noreply address", role, identity.Email),
+			context,
+		)
+
+		return
+	}
+
+	if (len(config.AllowedDomains) > 0 || len(allowedRegexes) > 0) &&
+		!hasAllowedDomain(identity.Email, config.AllowedDomains) && !matchesAny(allowedRegexes, identity.Email) {
+		rule.addError(
+			"email_not_allowed",
+			fmt.Sprintf("%s email %s is not in an allowed domain", role, identity.Email),
+			context,
+		)
+	}
+}
+
+// isNoreplyEmail reports whether the email is a noreply address.
+func isNoreplyEmail(email string) bool {
+	local, domain, _ := strings.Cut(strings.ToLower(email), "@")
+
+	return strings.Contains(local, "noreply") || strings.Contains(local, "no-reply") ||
+		strings.HasPrefix(domain, "noreply.") || strings.Contains(domain, ".noreply.")
+}
+
+// hasAllowedDomain reports whether the email belongs to one of the domains or their subdomains.
+func hasAllowedDomain(email string, domains []string) bool {
+	_, domain, _ := strings.Cut(strings.ToLower(email), "@")
+
+	for _, allowed := range domains {
+		allowed = strings.ToLower(strings.TrimPrefix(allowed, "@"))
+		if domain == allowed || strings.HasSuffix(domain, "."+allowed) {
+			return true
+		}
+	}
+
+	return false
+}
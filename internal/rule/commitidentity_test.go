@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/model"
+	"github.com/itiquette/gommitlint/internal/rule"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCommitIdentity(t *testing.T) {
+	mailmap, err := model.ParseMailmap("Jane Doe <jane@example.com> <jane.doe@gmail.com>\n")
+	require.NoError(t, err)
+
+	jane := model.Identity{Name: "Jane Doe", Email: "jane@example.com"}
+
+	tests := []struct {
+		name      string
+		author    model.Identity
+		committer model.Identity
+		isMerge   bool
+		opts      []rule.CommitIdentityOption
+		wantCodes []string
+	}{
+		{
+			name:      "no policy configured",
+			author:    model.Identity{Name: "root", Email: "root@localhost"},
+			committer: model.Identity{Name: "root", Email: "root@localhost"},
+		},
+		{
+			name:      "allowed domain",
+			author:    jane,
+			committer: jane,
+			opts:      []rule.CommitIdentityOption{rule.WithAllowedIdentityDomains([]string{"example.com"})},
+		},
+		{
+			name:      "root at localhost rejected",
+			author:    model.Identity{Name: "root", Email: "root@localhost"},
+			committer: model.Identity{Name: "root", Email: "root@localhost"},
+			opts:      []rule.CommitIdentityOption{rule.WithAllowedIdentityDomains([]string{"example.com"})},
+			wantCodes: []string{"email_not_allowed"},
+		},
+		{
+			name:      "pattern allows email outside domains",
+			author:    model.Identity{Name: "Build Bot", Email: "ci-bot@ci.internal"},
+			committer: model.Identity{Name: "Build Bot", Email: "ci-bot@ci.internal"},
+			opts: []rule.CommitIdentityOption{
+				rule.WithAllowedIdentityDomains([]string{"example.com"}),
+				rule.WithAllowedIdentityPatterns([]string{`^ci-bot@`}),
+			},
+		},
+		{
+			name:      "invalid pattern",
+			author:    jane,
+			committer: jane,
+			opts:      []rule.CommitIdentityOption{rule.WithAllowedIdentityPatterns([]string{`[`})},
+			wantCodes: []string{"invalid_pattern"},
+		},
+		{
+			name:      "single word name",
+			author:    model.Identity{Name: "jane", Email: "jane@example.com"},
+			committer: model.Identity{Name: "jane", Email: "jane@example.com"},
+			opts:      []rule.CommitIdentityOption{rule.WithFullNameRequired(true)},
+			wantCodes: []string{"name_not_full"},
+		},
+		{
+			name:      "noreply address",
+			author:    model.Identity{Name: "Jane Doe", Email: "123+jane@users.noreply.github.com"},
+			committer: model.Identity{Name: "Jane Doe", Email: "123+jane@users.noreply.github.com"},
+			opts:      []rule.CommitIdentityOption{rule.WithNoreplyForbidden(true)},
+			wantCodes: []string{"noreply_email"},
+		},
+		{
+			name:      "committer checked separately",
+			author:    jane,
+			committer: model.Identity{Name: "GitHub", Email: "noreply@github.com"},
+			opts: []rule.CommitIdentityOption{
+				rule.WithAllowedIdentityDomains([]string{"example.com"}),
+				rule.WithNoreplyForbidden(true),
+			},
+			wantCodes: []string{"noreply_email"},
+		},
+		{
+			name:      "committer differs from author",
+			author:    jane,
+			committer: model.Identity{Name: "John Smith", Email: "john@example.com"},
+			opts:      []rule.CommitIdentityOption{rule.WithSameCommitterRequired(true)},
+			wantCodes: []string{"author_committer_mismatch"},
+		},
+		{
+			name:      "merge commit may have another committer",
+			author:    jane,
+			committer: model.Identity{Name: "John Smith", Email: "john@example.com"},
+			isMerge:   true,
+			opts:      []rule.CommitIdentityOption{rule.WithSameCommitterRequired(true)},
+		},
+		{
+			name:      "mailmap canonicalises personal address",
+			author:    model.Identity{Name: "jane", Email: "jane.doe@gmail.com"},
+			committer: jane,
+			opts: []rule.CommitIdentityOption{
+				rule.WithIdentityMailmap(mailmap),
+				rule.WithAllowedIdentityDomains([]string{"example.com"}),
+				rule.WithFullNameRequired(true),
+				rule.WithSameCommitterRequired(true),
+			},
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			result := rule.ValidateCommitIdentity(tabletest.author, tabletest.committer, tabletest.isMerge, tabletest.opts...)
+
+			var codes []string
+			for _, err := range result.Errors() {
+				codes = append(codes, err.Code)
+			}
+
+			require.Equal(t, tabletest.wantCodes, codes)
+			require.Equal(t, "CommitIdentity", result.Name())
+
+			if len(codes) == 0 {
+				require.Equal(t, "Commit identity OK", result.Result())
+				require.Equal(t, "No errors to fix", result.Help())
+			} else {
+				require.Equal(t, "Invalid commit identity", result.Result())
+				require.NotEqual(t, "No errors to fix", result.Help())
+			}
+		})
+	}
+}
@@ -0,0 +1,296 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/git"
+	"github.com/itiquette/gommitlint/internal/model"
+)
+
+// CommitSizeConfig provides configuration for the CommitSize rule.
+// A zero limit disables the corresponding check.
+type CommitSizeConfig struct {
+	// MaxFiles is the maximum number of changed files
+	MaxFiles int
+
+	// MaxLines is the maximum number of added and deleted lines
+	MaxLines int
+
+	// MaxFileSize is the maximum size in bytes of an added or modified file
+	MaxFileSize int64
+
+	// ForbidBinary rejects added or modified binary files
+	ForbidBinary bool
+
+	// ExcludePatterns lists path patterns that are not counted, e.g. lock files and generated code
+	ExcludePatterns []string
+}
+
+// CommitSizeOption configures a CommitSizeConfig.
+type CommitSizeOption func(*CommitSizeConfig)
+
+// WithMaxFiles sets the maximum number of changed files.
+func WithMaxFiles(maxFiles int) CommitSizeOption {
+	return func(c *CommitSizeConfig) {
+		c.MaxFiles = maxFiles
+	}
+}
+
+// WithMaxLines sets the maximum number of added and deleted lines.
+func WithMaxLines(maxLines int) CommitSizeOption {
+	return func(c *CommitSizeConfig) {
+		c.MaxLines = maxLines
+	}
+}
+
+// WithMaxFileSize sets the maximum size in bytes of an added or modified file.
+func WithMaxFileSize(maxFileSize int64) CommitSizeOption {
+	return func(c *CommitSizeConfig) {
+		c.MaxFileSize = maxFileSize
+	}
+}
+
+// WithBinaryForbidden sets whether added or modified binary files are rejected.
+func WithBinaryForbidden(forbid bool) CommitSizeOption {
+	return func(c *CommitSizeConfig) {
+		c.ForbidBinary = forbid
+	}
+}
+
+// WithSizeExclusions excludes files matching the path patterns from all checks.
+func WithSizeExclusions(patterns []string) CommitSizeOption {
+	return func(c *CommitSizeConfig) {
+		c.ExcludePatterns = append(c.ExcludePatterns, patterns...)
+	}
+}
+
+// CommitSize limits the size of a commit.
+//
+// Large commits are hard to review and binary blobs bloat the repository for good.
+// The rule compares the commit to its first parent and checks:
+//
+//   - the number of changed files
+//   - the number of added and deleted lines
+//   - the size of the largest added or modified file
+//   - whether binary files are added or modified
+//
+// Files matching an exclude pattern, such as lock files and generated code, are not
+// counted. Deleted files only count towards the number of files and lines. Lines are only
+// counted when a maximum is set, since counting them needs a diff of every file.
+//
+// Examples:
+//
+//   - With a maximum of 400 lines, a commit adding 350 and deleting 100 lines would fail
+//   - With binaries forbidden, a commit adding logo.png would fail
+//   - With go.sum excluded, a commit changing 2000 lines of go.sum would pass
+type CommitSize struct {
+	countLines  bool
+	files       int
+	additions   int
+	deletions   int
+	largestPath string
+	largestSize int64
+	errors      []*model.ValidationError
+}
+
+// Name returns the rule name.
+func (rule CommitSize) Name() string {
+	return "CommitSize"
+}
+
+// Result returns a concise validation result.
+func (rule CommitSize) Result() string {
+	if len(rule.errors) > 0 {
+		return "Commit too large"
+	}
+
+	if !rule.countLines {
+		return fmt.Sprintf("%d file(s)", rule.files)
+	}
+
+	return fmt.Sprintf("%d file(s), +%d/-%d lines", rule.files, rule.additions, rule.deletions)
+}
+
+// VerboseResult returns a more detailed explanation for verbose mode.
+func (rule CommitSize) VerboseResult() string {
+	if len(rule.errors) > 0 {
+		switch rule.errors[0].Code {
+		case "too_many_files":
+			return fmt.Sprintf("Commit changes %s files (maximum allowed: %s)",
+				rule.errors[0].Context["files"], rule.errors[0].Context["max_files"])
+		case "too_many_lines":
+			return fmt.Sprintf("Commit changes %s lines (maximum allowed: %s)",
+				rule.errors[0].Context["lines"], rule.errors[0].Context["max_lines"])
+		case "file_too_large":
+			return fmt.Sprintf("File %s has %s bytes (maximum allowed: %s)",
+				rule.errors[0].Context["path"], rule.errors[0].Context["size"], rule.errors[0].Context["max_size"])
+		case "binary_file":
+			return fmt.Sprintf("Commit adds or modifies the binary file %s", rule.errors[0].Context["path"])
+		default:
+			return rule.errors[0].Error()
+		}
+	}
+
+	result := fmt.Sprintf("Commit changes %d file(s)", rule.files)
+	if rule.countLines {
+		result += fmt.Sprintf(" with %d added and %d deleted lines", rule.additions, rule.deletions)
+	}
+
+	if rule.largestPath != "" {
+		result += fmt.Sprintf(", largest file %s has %d bytes", rule.largestPath, rule.largestSize)
+	}
+
+	return result
+}
+
+// addError adds a structured validation error.
+func (rule *CommitSize) addError(code, message string, context map[string]string) {
+	err := model.NewValidationError("CommitSize", code, message)
+
+	// Add any context values
+	for key, value := range context {
+		_ = err.WithContext(key, value)
+	}
+
+	rule.errors = append(rule.errors, err)
+}
+
+// Errors returns validation errors.
+func (rule CommitSize) Errors() []*model.ValidationError {
+	return rule.errors
+}
+
+// Help returns a description of how to fix the rule violation.
+func (rule CommitSize) Help() string {
+	if len(rule.errors) == 0 {
+		return "No errors to fix"
+	}
+
+	switch rule.errors[0].Code {
+	case "too_many_files", "too_many_lines":
+		return `Split the commit into smaller commits that can be reviewed one by one,
+e.g. separate refactorings from behaviour changes:
+
+  git reset HEAD~1
+  git add -p
+
+Generated files and lock files can be excluded with 'commit-size.exclude'.`
+
+	case "file_too_large", "binary_file":
+		var paths []string
+
+		for _, err := range rule.errors {
+			if err.Code == "file_too_large" || err.Code == "binary_file" {
+				paths = append(paths, err.Context["path"])
+			}
+		}
+
+		return fmt.Sprintf(`Remove these files from the commit: %s
+
+Large and binary files stay in the repository history forever. Store them with
+Git LFS or outside the repository, or exclude the path with 'commit-size.exclude'
+if it belongs in the repository.`, strings.Join(paths, ", "))
+	}
+
+	return rule.errors[0].Message
+}
+
+// ValidateCommitSize checks the number of changed files and lines and the size of the changed files.
+//
+// Parameters:
+//   - changes: The files the commit changes compared to its first parent
+//   - opts: Options setting the limits
+//
+// Returns:
+//   - A CommitSize instance with validation results
+func ValidateCommitSize(changes []git.FileChange, opts ...CommitSizeOption) *CommitSize {
+	var config CommitSizeConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	rule := &CommitSize{countLines: config.MaxLines > 0}
+
+	var oversized, binaries []git.FileChange
+
+	for _, change := range changes {
+		if matchesAnyPath(config.ExcludePatterns, change.Path) {
+			continue
+		}
+
+		rule.files++
+		rule.additions += change.Additions
+		rule.deletions += change.Deletions
+
+		if change.IsDeleted {
+			continue
+		}
+
+		if change.Size > rule.largestSize {
+			rule.largestPath = change.Path
+			rule.largestSize = change.Size
+		}
+
+		if config.MaxFileSize > 0 && change.Size > config.MaxFileSize {
+			oversized = append(oversized, change)
+		}
+
+		if config.ForbidBinary && change.IsBinary {
+			binaries = append(binaries, change)
+		}
+	}
+
+	if config.MaxFiles > 0 && rule.files > config.MaxFiles {
+		rule.addError(
+			"too_many_files",
+			fmt.Sprintf("commit changes too many files: %d (maximum: %d)", rule.files, config.MaxFiles),
+			map[string]string{
+				"files":     strconv.Itoa(rule.files),
+				"max_files": strconv.Itoa(config.MaxFiles),
+			},
+		)
+	}
+
+	if lines := rule.additions + rule.deletions; config.MaxLines > 0 && lines > config.MaxLines {
+		rule.addError(
+			"too_many_lines",
+			fmt.Sprintf("commit changes too many lines: %d (maximum: %d)", lines, config.MaxLines),
+			map[string]string{
+				"lines":     strconv.Itoa(lines),
+				"additions": strconv.Itoa(rule.additions),
+				"deletions": strconv.Itoa(rule.deletions),
+				"max_lines": strconv.Itoa(config.MaxLines),
+			},
+		)
+	}
+
+	for _, change := range oversized {
+		rule.addError(
+			"file_too_large",
+			fmt.Sprintf("file %s is too large: %d bytes (maximum: %d)", change.Path, change.Size, config.MaxFileSize),
+			map[string]string{
+				"path":     change.Path,
+				"size":     strconv.FormatInt(change.Size, 10),
+				"max_size": strconv.FormatInt(config.MaxFileSize, 10),
+			},
+		)
+	}
+
+	for _, change := range binaries {
+		rule.addError(
+			"binary_file",
+			"binary file "+change.Path+" must not be committed",
+			map[string]string{
+				"path": change.Path,
+			},
+		)
+	}
+
+	return rule
+}
@@ -0,0 +1,137 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/git"
+	"github.com/itiquette/gommitlint/internal/rule"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCommitSize(t *testing.T) {
+	changes := []git.FileChange{
+		{Path: "main.go", OldPath: "main.go", Additions: 120, Deletions: 30, Size: 4000},
+		{Path: "go.sum", OldPath: "go.sum", Additions: 900, Deletions: 400, Size: 90000},
+		{Path: "assets/logo.png", IsBinary: true, Size: 250000},
+		{Path: "old/huge.bin", OldPath: "old/huge.bin", IsBinary: true, IsDeleted: true},
+	}
+
+	tests := []struct {
+		name      string
+		changes   []git.FileChange
+		opts      []rule.CommitSizeOption
+		wantCodes []string
+	}{
+		{
+			name:    "no limits",
+			changes: changes,
+		},
+		{
+			name:    "no changes",
+			changes: nil,
+			opts:    []rule.CommitSizeOption{rule.WithMaxFiles(1), rule.WithMaxLines(1)},
+		},
+		{
+			name:      "too many files",
+			changes:   changes,
+			opts:      []rule.CommitSizeOption{rule.WithMaxFiles(3)},
+			wantCodes: []string{"too_many_files"},
+		},
+		{
+			name:      "too many lines",
+			changes:   changes,
+			opts:      []rule.CommitSizeOption{rule.WithMaxLines(1000)},
+			wantCodes: []string{"too_many_lines"},
+		},
+		{
+			name:    "excluded lock file is not counted",
+			changes: changes,
+			opts: []rule.CommitSizeOption{
+				rule.WithMaxFiles(3),
+				rule.WithMaxLines(1000),
+				rule.WithSizeExclusions([]string{"go.sum"}),
+			},
+		},
+		{
+			name:      "file too large",
+			changes:   changes,
+			opts:      []rule.CommitSizeOption{rule.WithMaxFileSize(100000)},
+			wantCodes: []string{"file_too_large"},
+		},
+		{
+			name:      "binary file",
+			changes:   changes,
+			opts:      []rule.CommitSizeOption{rule.WithBinaryForbidden(true)},
+			wantCodes: []string{"binary_file"},
+		},
+		{
+			name:    "excluded binary file",
+			changes: changes,
+			opts: []rule.CommitSizeOption{
+				rule.WithBinaryForbidden(true),
+				rule.WithMaxFileSize(100000),
+				rule.WithSizeExclusions([]string{"assets/"}),
+			},
+		},
+		{
+			name:    "all limits exceeded",
+			changes: changes,
+			opts: []rule.CommitSizeOption{
+				rule.WithMaxFiles(2),
+				rule.WithMaxLines(100),
+				rule.WithMaxFileSize(50000),
+				rule.WithBinaryForbidden(true),
+			},
+			wantCodes: []string{"too_many_files", "too_many_lines", "file_too_large", "file_too_large", "binary_file"},
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			result := rule.ValidateCommitSize(tabletest.changes, tabletest.opts...)
+
+			codes := make([]string, 0, len(result.Errors()))
+			for _, err := range result.Errors() {
+				codes = append(codes, err.Code)
+			}
+
+			if len(tabletest.wantCodes) == 0 {
+				require.Empty(t, codes)
+				require.Equal(t, "No errors to fix", result.Help())
+
+				return
+			}
+
+			require.Equal(t, tabletest.wantCodes, codes)
+			require.Equal(t, "Commit too large", result.Result())
+		})
+	}
+}
+
+func TestCommitSizeResult(t *testing.T) {
+	result := rule.ValidateCommitSize([]git.FileChange{
+		{Path: "main.go", Additions: 10, Deletions: 2, Size: 300},
+		{Path: "README.md", Additions: 1, Size: 40},
+	}, rule.WithMaxLines(400))
+
+	require.Equal(t, "2 file(s), +11/-2 lines", result.Result())
+	require.Contains(t, result.VerboseResult(), "largest file main.go has 300 bytes")
+
+	// Without a maximum the lines are not counted
+	result = rule.ValidateCommitSize([]git.FileChange{{Path: "main.go", Size: 300}})
+	require.Equal(t, "1 file(s)", result.Result())
+	require.Equal(t, "Commit changes 1 file(s), largest file main.go has 300 bytes", result.VerboseResult())
+}
+
+func TestCommitSizeHelpListsFiles(t *testing.T) {
+	result := rule.ValidateCommitSize([]git.FileChange{
+		{Path: "a.bin", IsBinary: true, Size: 10},
+		{Path: "b.bin", IsBinary: true, Size: 10},
+	}, rule.WithBinaryForbidden(true))
+
+	require.Contains(t, result.Help(), "a.bin, b.bin")
+}
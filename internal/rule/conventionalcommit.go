@@ -5,7 +5,6 @@
 package rule
 
 import (
-	"regexp"
 	"slices"
 	"strconv"
 	"strings"
@@ -14,7 +13,23 @@ import (
 )
 
 // SubjectRegex Format: type(scope)!: description.
-var SubjectRegex = regexp.MustCompile(`^(\w+)(?:\(([\w,/-]+)\))?(!)?:[ ](.+)$`)
+var SubjectRegex = model.ConventionalSubjectRegex
+
+// ConventionalCommitConfig provides configuration for the ConventionalCommit rule.
+type ConventionalCommitConfig struct {
+	// Message, if set, is the parsed full message whose body and footers are validated too
+	Message *model.ConventionalCommit
+}
+
+// ConventionalCommitOption configures a ConventionalCommitConfig.
+type ConventionalCommitOption func(*ConventionalCommitConfig)
+
+// WithConventionalMessage validates the body and footer structure of the parsed message as well.
+func WithConventionalMessage(message *model.ConventionalCommit) ConventionalCommitOption {
+	return func(c *ConventionalCommitConfig) {
+		c.Message = message
+	}
+}
 
 // ConventionalCommit enforces the Conventional Commits specification format for commit messages.
 //
@@ -50,11 +65,15 @@ var SubjectRegex = regexp.MustCompile(`^(\w+)(?:\(([\w,/-]+)\))?(!)?:[ ](.+)$`)
 //
 // If configured with allowed types or scopes, the rule also validates that the
 // commit uses only approved types and scopes according to project conventions.
+//
+// Given the parsed full message, the rule also checks that the body is separated from
+// the subject by a blank line, as the specification requires.
 type ConventionalCommit struct {
 	errors      []*model.ValidationError
 	commitType  string // Store for verbose output
 	scope       string // Store for verbose output
 	hasBreaking bool   // Store for verbose output
+	footers     int    // Store for verbose output
 }
 
 // Name returns the rule identifier.
@@ -118,6 +137,8 @@ func (c ConventionalCommit) VerboseResult() string {
 			return "Description too long (" + actualLength + " chars). Maximum length is " + maxLength + " characters"
 		case "spacing_error":
 			return "Spacing error: Must have exactly one space after colon"
+		case "missing_blank_line":
+			return "Missing blank line between subject and body"
 		default:
 			return c.errors[0].Error()
 		}
@@ -133,6 +154,10 @@ func (c ConventionalCommit) VerboseResult() string {
 		result += " (breaking change)"
 	}
 
+	if c.footers > 0 {
+		result += " and " + strconv.Itoa(c.footers) + " footer(s)"
+	}
+
 	return result
 }
 
@@ -187,6 +212,13 @@ Consider breaking down large changes into multiple smaller commits if possible.`
 			return `There should be exactly one space after the colon in your commit message.
 Correct: feat: add feature
 Incorrect: feat:add feature or feat:  add feature`
+
+		case "missing_blank_line":
+			return `Separate the body from the subject with a blank line:
+
+feat: add login
+
+Users can now sign in with their company account.`
 		}
 	}
 
@@ -223,6 +255,7 @@ func (c *ConventionalCommit) AddTestError(code, message string, context map[stri
 //   - types: Optional list of allowed commit types (e.g., feat, fix, docs)
 //   - scopes: Optional list of allowed commit scopes (e.g., auth, ui, api)
 //   - descLength: Maximum allowed description length (0 means use default of 72)
+//   - opts: Options, e.g. the parsed message to validate the body structure
 //
 // The function validates several aspects of the conventional commit format:
 //  1. Basic format compliance (type(scope)!: description)
@@ -231,13 +264,19 @@ func (c *ConventionalCommit) AddTestError(code, message string, context map[stri
 //  4. Valid scope (if allowed scopes are specified)
 //  5. Non-empty description
 //  6. Description length within limits
+//  7. Blank line between subject and body (if the parsed message is given)
 //
 // For multi-scope commits using comma separators (e.g., "feat(ui,api)"), each scope
 // is individually validated against the allowed scopes list.
 //
 // Returns:
 //   - A ConventionalCommit instance with validation results
-func ValidateConventionalCommit(subject string, types []string, scopes []string, descLength int) ConventionalCommit {
+func ValidateConventionalCommit(subject string, types []string, scopes []string, descLength int, opts ...ConventionalCommitOption) ConventionalCommit {
+	var config ConventionalCommitConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
 	rule := ConventionalCommit{}
 
 	// Handle empty subject early
@@ -367,5 +406,20 @@ func ValidateConventionalCommit(subject string, types []string, scopes []string,
 		return rule
 	}
 
+	if config.Message != nil {
+		rule.hasBreaking = config.Message.Breaking
+		rule.footers = len(config.Message.Footers)
+
+		if !config.Message.BlankLineAfterSubject {
+			rule.addError(
+				"missing_blank_line",
+				"missing blank line between subject and body",
+				map[string]string{
+					"subject": subject,
+				},
+			)
+		}
+	}
+
 	return rule
 }
@@ -9,8 +9,10 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/itiquette/gommitlint/internal/model"
 	"github.com/itiquette/gommitlint/internal/rule"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // TestConventionalCommitRule covers the basic validation functionality.
@@ -516,3 +518,43 @@ func repeat(n int) string {
 
 	return sb.String()
 }
+
+// TestConventionalCommitMessage tests the checks on the full parsed message.
+func TestConventionalCommitMessage(t *testing.T) {
+	tests := []struct {
+		name      string
+		message   string
+		errorCode string
+	}{
+		{
+			name:    "Subject with body and footers",
+			message: "feat: add login\n\nUsers can sign in.\n\nRefs: #12",
+		},
+		{
+			name:    "Subject only",
+			message: "feat: add login",
+		},
+		{
+			name:      "Body without blank line",
+			message:   "feat: add login\nUsers can sign in.",
+			errorCode: "missing_blank_line",
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			info := model.NewCommitInfo(tabletest.message, nil)
+			result := rule.ValidateConventionalCommit(info.Subject, []string{"feat"}, nil, 72,
+				rule.WithConventionalMessage(info.Conventional))
+
+			if tabletest.errorCode == "" {
+				require.Empty(t, result.Errors())
+
+				return
+			}
+
+			require.Len(t, result.Errors(), 1)
+			require.Equal(t, tabletest.errorCode, result.Errors()[0].Code)
+		})
+	}
+}
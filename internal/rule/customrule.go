@@ -0,0 +1,322 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/configuration"
+	"github.com/itiquette/gommitlint/internal/model"
+)
+
+// Targets of custom rules.
+const (
+	// TargetSubject is the subject line.
+	TargetSubject = "subject"
+
+	// TargetBody is the message after the subject.
+	TargetBody = "body"
+
+	// TargetTrailer is the value of each trailer with the configured key, or each
+	// "Key: value" trailer line if no key is configured.
+	TargetTrailer = "trailer"
+
+	// TargetAuthor is the author as "Name <email>".
+	TargetAuthor = "author"
+
+	// TargetMessage is the full commit message.
+	TargetMessage = "message"
+)
+
+// customRuleTargets lists the supported targets, which are checked when the configuration is loaded.
+var customRuleTargets = configuration.CustomRuleTargets
+
+// CustomRuleSpec declares a rule that checks part of a commit against regular expressions.
+type CustomRuleSpec struct {
+	// Name is the rule name shown in reports
+	Name string
+
+	// Target is the part of the commit that is checked, e.g. TargetSubject
+	Target string
+
+	// Trailer is the trailer key checked by TargetTrailer, e.g. "Tested"
+	Trailer string
+
+	// MustMatch lists regular expressions that must each match the target
+	MustMatch []string
+
+	// MustNotMatch lists regular expressions that must not match the target
+	MustNotMatch []string
+
+	// MustMatchRegexes and MustNotMatchRegexes, if set, are the compiled expressions,
+	// e.g. compiled when the configuration was loaded
+	MustMatchRegexes    []*regexp.Regexp
+	MustNotMatchRegexes []*regexp.Regexp
+
+	// Message is the result shown when the rule fails
+	Message string
+
+	// Help describes how to fix a failure
+	Help string
+}
+
+// CustomRuleCommit holds the parts of a commit that custom rules can check.
+type CustomRuleCommit struct {
+	Message string // Full commit message
+	Author  string // Author as "Name <email>", empty if unknown
+}
+
+// CustomRule is a rule declared in the configuration.
+//
+// Team policies are often simple patterns, such as "the subject must not contain
+// [skip ci]" or "the body must contain a Tested: trailer". A custom rule checks one
+// target of the commit, the subject, body, trailers, author or full message, with
+// regular expressions that must or must not match, and reports its own message and
+// help text.
+//
+// The target matches a must-match expression if any of its values does, e.g. any
+// trailer with the configured key; a missing trailer matches no expression.
+//
+// Examples:
+//
+//   - With target "subject" and must-not-match "\[skip ci\]":
+//     "Fix crash" would pass
+//     "Fix crash [skip ci]" would fail
+//   - With target "trailer", trailer "Tested" and must-match ".+":
+//     a message ending in "Tested: unit tests" would pass
+type CustomRule struct {
+	spec   CustomRuleSpec
+	errors []*model.ValidationError
+}
+
+// Name returns the rule name.
+func (rule CustomRule) Name() string {
+	return rule.spec.Name
+}
+
+// Result returns a concise validation result.
+func (rule CustomRule) Result() string {
+	if len(rule.errors) == 0 {
+		return "Passed"
+	}
+
+	if rule.spec.Message != "" && (rule.errors[0].Code == "missing_match" || rule.errors[0].Code == "forbidden_match") {
+		return rule.spec.Message
+	}
+
+	return "Failed"
+}
+
+// VerboseResult returns a more detailed explanation for verbose mode.
+func (rule CustomRule) VerboseResult() string {
+	if len(rule.errors) == 0 {
+		return fmt.Sprintf("The %s matches the rule's patterns", rule.targetName())
+	}
+
+	switch rule.errors[0].Code {
+	case "missing_match":
+		return fmt.Sprintf("The %s does not match '%s'", rule.targetName(), rule.errors[0].Context["pattern"])
+	case "forbidden_match":
+		return fmt.Sprintf("The %s matches '%s' at '%s'", rule.targetName(),
+			rule.errors[0].Context["pattern"], rule.errors[0].Context["match"])
+	default:
+		return rule.errors[0].Error()
+	}
+}
+
+// targetName describes the target in results.
+func (rule CustomRule) targetName() string {
+	if rule.spec.Target == TargetTrailer && rule.spec.Trailer != "" {
+		return rule.spec.Trailer + " trailer"
+	}
+
+	return rule.spec.Target
+}
+
+// addError adds a structured validation error.
+func (rule *CustomRule) addError(code, message string, context map[string]string) {
+	err := model.NewValidationError(rule.spec.Name, code, message)
+
+	// Add any context values
+	for key, value := range context {
+		_ = err.WithContext(key, value)
+	}
+
+	rule.errors = append(rule.errors, err)
+}
+
+// Errors returns validation errors.
+func (rule CustomRule) Errors() []*model.ValidationError {
+	return rule.errors
+}
+
+// Help returns a description of how to fix the rule violation.
+func (rule CustomRule) Help() string {
+	if len(rule.errors) == 0 {
+		return "No errors to fix"
+	}
+
+	switch rule.errors[0].Code {
+	case "invalid_target":
+		return fmt.Sprintf("Set the target of custom rule '%s' to one of: %s",
+			rule.spec.Name, strings.Join(customRuleTargets, ", "))
+
+	case "invalid_pattern":
+		return fmt.Sprintf("Fix the patterns of custom rule '%s' so that every entry is a valid Go regular expression",
+			rule.spec.Name)
+	}
+
+	if rule.spec.Help != "" {
+		return rule.spec.Help
+	}
+
+	if rule.errors[0].Code == "missing_match" {
+		return fmt.Sprintf("Change the %s to match the pattern '%s'.", rule.targetName(), rule.errors[0].Context["pattern"])
+	}
+
+	return fmt.Sprintf("Remove '%s' from the %s.", rule.errors[0].Context["match"], rule.targetName())
+}
+
+// ValidateCustomRule checks a commit against a custom rule.
+//
+// Parameters:
+//   - spec: The rule declaration
+//   - commit: The commit message and author
+//
+// Returns:
+//   - A CustomRule instance with validation results
+func ValidateCustomRule(spec CustomRuleSpec, commit CustomRuleCommit) *CustomRule {
+	rule := &CustomRule{spec: spec}
+
+	if !slices.Contains(customRuleTargets, spec.Target) {
+		rule.addError(
+			"invalid_target",
+			fmt.Sprintf("unknown target %q of custom rule %s", spec.Target, spec.Name),
+			map[string]string{
+				"target":            spec.Target,
+				"supported_targets": strings.Join(customRuleTargets, ","),
+			},
+		)
+
+		return rule
+	}
+
+	mustMatch, mustNotMatch := spec.MustMatchRegexes, spec.MustNotMatchRegexes
+
+	var err error
+
+	if mustMatch == nil {
+		if mustMatch, err = compilePatterns(spec.MustMatch); err != nil {
+			rule.addInvalidPattern(err)
+
+			return rule
+		}
+	}
+
+	if mustNotMatch == nil {
+		if mustNotMatch, err = compilePatterns(spec.MustNotMatch); err != nil {
+			rule.addInvalidPattern(err)
+
+			return rule
+		}
+	}
+
+	rule.check(customRuleValues(spec, commit), mustMatch, mustNotMatch)
+
+	return rule
+}
+
+// addInvalidPattern reports a pattern that is not a valid regular expression.
+func (rule *CustomRule) addInvalidPattern(err error) {
+	rule.addError(
+		"invalid_pattern",
+		fmt.Sprintf("invalid pattern in custom rule %s: %s", rule.spec.Name, err),
+		map[string]string{
+			"error": err.Error(),
+		},
+	)
+}
+
+// check reports the patterns that match none of the values, and the forbidden patterns
+// that match any of them.
+func (rule *CustomRule) check(values []string, mustMatch, mustNotMatch []*regexp.Regexp) {
+	for _, patternRegex := range mustMatch {
+		if !slices.ContainsFunc(values, patternRegex.MatchString) {
+			rule.addError(
+				"missing_match",
+				fmt.Sprintf("%s does not match %q", rule.targetName(), patternRegex),
+				map[string]string{
+					"target":  rule.spec.Target,
+					"pattern": patternRegex.String(),
+				},
+			)
+		}
+	}
+
+	for _, patternRegex := range mustNotMatch {
+		for _, value := range values {
+			if loc := patternRegex.FindStringIndex(value); loc != nil {
+				rule.addError(
+					"forbidden_match",
+					fmt.Sprintf("%s matches %q", rule.targetName(), patternRegex),
+					map[string]string{
+						"target":  rule.spec.Target,
+						"pattern": patternRegex.String(),
+						"match":   value[loc[0]:loc[1]],
+					},
+				)
+
+				break
+			}
+		}
+	}
+}
+
+// customRuleValues returns the values of the commit that a rule checks.
+func customRuleValues(spec CustomRuleSpec, commit CustomRuleCommit) []string {
+	subject, body := model.SplitCommitMessage(commit.Message)
+
+	switch spec.Target {
+	case TargetSubject:
+		return []string{subject}
+	case TargetBody:
+		return []string{body}
+	case TargetAuthor:
+		return []string{commit.Author}
+	case TargetMessage:
+		return []string{commit.Message}
+	}
+
+	trailers := model.ParseTrailers(commit.Message)
+	if spec.Trailer != "" {
+		return trailers.Values(spec.Trailer)
+	}
+
+	values := make([]string, 0, len(trailers.Block))
+	for _, trailer := range trailers.Block {
+		values = append(values, trailer.Key+": "+trailer.Value)
+	}
+
+	return values
+}
+
+// compilePatterns compiles regular expressions, stopping at the first invalid one.
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	regexes := make([]*regexp.Regexp, 0, len(patterns))
+
+	for _, pattern := range patterns {
+		patternRegex, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, err
+		}
+
+		regexes = append(regexes, patternRegex)
+	}
+
+	return regexes, nil
+}
@@ -0,0 +1,148 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule_test
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/rule"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCustomRule(t *testing.T) {
+	message := "Fix crash on upload\n\nThe buffer was freed twice.\n\nTested: unit tests\nSigned-off-by: Jane Doe <jane@example.com>"
+
+	tests := []struct {
+		name      string
+		spec      rule.CustomRuleSpec
+		message   string
+		wantCodes []string
+	}{
+		{
+			name: "subject without forbidden pattern",
+			spec: rule.CustomRuleSpec{Target: rule.TargetSubject, MustNotMatch: []string{`\[skip ci\]`}},
+		},
+		{
+			name:      "subject with forbidden pattern",
+			spec:      rule.CustomRuleSpec{Target: rule.TargetSubject, MustNotMatch: []string{`\[skip ci\]`}},
+			message:   "Fix crash [skip ci]",
+			wantCodes: []string{"forbidden_match"},
+		},
+		{
+			name: "body matches",
+			spec: rule.CustomRuleSpec{Target: rule.TargetBody, MustMatch: []string{`(?m)^Tested:`}},
+		},
+		{
+			name:      "subject does not match",
+			spec:      rule.CustomRuleSpec{Target: rule.TargetSubject, MustMatch: []string{`^(Add|Fix) `, `#\d+`}},
+			wantCodes: []string{"missing_match"},
+		},
+		{
+			name: "trailer present",
+			spec: rule.CustomRuleSpec{Target: rule.TargetTrailer, Trailer: "tested", MustMatch: []string{`.+`}},
+		},
+		{
+			name:      "trailer missing",
+			spec:      rule.CustomRuleSpec{Target: rule.TargetTrailer, Trailer: "Reviewed-by", MustMatch: []string{`.+`}},
+			wantCodes: []string{"missing_match"},
+		},
+		{
+			name: "missing trailer is not forbidden",
+			spec: rule.CustomRuleSpec{Target: rule.TargetTrailer, Trailer: "Reviewed-by", MustNotMatch: []string{`.+`}},
+		},
+		{
+			name:      "any trailer line",
+			spec:      rule.CustomRuleSpec{Target: rule.TargetTrailer, MustNotMatch: []string{`^Signed-off-by: .*@example\.com`}},
+			wantCodes: []string{"forbidden_match"},
+		},
+		{
+			name: "author",
+			spec: rule.CustomRuleSpec{Target: rule.TargetAuthor, MustMatch: []string{`@example\.com>$`}},
+		},
+		{
+			name:      "full message",
+			spec:      rule.CustomRuleSpec{Target: rule.TargetMessage, MustNotMatch: []string{`(?i)do not merge`, `freed twice`}},
+			wantCodes: []string{"forbidden_match"},
+		},
+		{
+			name:      "invalid target",
+			spec:      rule.CustomRuleSpec{Target: "committer"},
+			wantCodes: []string{"invalid_target"},
+		},
+		{
+			name:      "invalid pattern",
+			spec:      rule.CustomRuleSpec{Target: rule.TargetSubject, MustNotMatch: []string{`(`}},
+			wantCodes: []string{"invalid_pattern"},
+		},
+		{
+			// The compiled expressions are used instead of the patterns
+			name: "precompiled patterns",
+			spec: rule.CustomRuleSpec{
+				Target:              rule.TargetSubject,
+				MustNotMatch:        []string{`(`},
+				MustNotMatchRegexes: []*regexp.Regexp{regexp.MustCompile(`(?i)crash`)},
+			},
+			wantCodes: []string{"forbidden_match"},
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			tabletest.spec.Name = "TeamPolicy"
+
+			commitMessage := message
+			if tabletest.message != "" {
+				commitMessage = tabletest.message
+			}
+
+			result := rule.ValidateCustomRule(tabletest.spec, rule.CustomRuleCommit{
+				Message: commitMessage,
+				Author:  "Jane Doe <jane@example.com>",
+			})
+
+			require.Equal(t, "TeamPolicy", result.Name())
+
+			codes := make([]string, 0, len(result.Errors()))
+			for _, err := range result.Errors() {
+				codes = append(codes, err.Code)
+				require.Equal(t, "TeamPolicy", err.Rule)
+			}
+
+			if len(tabletest.wantCodes) == 0 {
+				require.Empty(t, codes)
+				require.Equal(t, "Passed", result.Result())
+				require.Equal(t, "No errors to fix", result.Help())
+
+				return
+			}
+
+			require.Equal(t, tabletest.wantCodes, codes)
+			require.Equal(t, "Failed", result.Result())
+			require.NotEmpty(t, result.VerboseResult())
+			require.NotEmpty(t, result.Help())
+		})
+	}
+}
+
+func TestCustomRuleMessageAndHelp(t *testing.T) {
+	spec := rule.CustomRuleSpec{
+		Name:         "NoSkipCI",
+		Target:       rule.TargetSubject,
+		MustNotMatch: []string{`\[skip ci\]`},
+		Message:      "Do not skip CI on main",
+		Help:         "Remove [skip ci] from the subject.",
+	}
+
+	result := rule.ValidateCustomRule(spec, rule.CustomRuleCommit{Message: "Fix crash [skip ci]"})
+	require.Equal(t, "Do not skip CI on main", result.Result())
+	require.Equal(t, `The subject matches '\[skip ci\]' at '[skip ci]'`, result.VerboseResult())
+	require.Equal(t, "Remove [skip ci] from the subject.", result.Help())
+
+	spec = rule.CustomRuleSpec{Name: "Tested", Target: rule.TargetTrailer, Trailer: "Tested", MustMatch: []string{`.+`}}
+	result = rule.ValidateCustomRule(spec, rule.CustomRuleCommit{Message: "Fix crash"})
+	require.Equal(t, "The Tested trailer does not match '.+'", result.VerboseResult())
+	require.Equal(t, "Change the Tested trailer to match the pattern '.+'.", result.Help())
+}
@@ -20,14 +20,36 @@ The package contains several categories of rules:
 
 Commit Message Content Rules:
 
+  - AutosquashLeftover: Rejects fixup!, squash! and amend! commits and
+    work-in-progress subjects that must not be merged.
+
+  - BannedTerms: Rejects banned words and phrases, such as non-inclusive terms,
+    suggesting alternatives and allowing them in code.
+
+  - BreakingChange: Checks that the "!" marker and the BREAKING CHANGE footer of
+    conventional commits are used consistently.
+
+  - CoAuthors: Validates Co-authored-by trailers, rejecting malformed and duplicate
+    lines and optionally co-authors outside allowed domains or a mailmap.
+
+  - CommitIdentity: Enforces a policy on commit author and committer names and
+    emails, canonicalised with a mailmap.
+
   - ConventionalCommit: Enforces the Conventional Commits specification format
-    (https://www.conventionalcommits.org/) with optional type and scope restrictions.
+    (https://www.conventionalcommits.org/) with optional type and scope restrictions,
+    and requires a blank line between the subject and the body.
 
   - ImperativeVerb: Validates that commit messages begin with a verb in the
     imperative mood, following Git conventions.
 
+  - Revert: Validates revert commits, which must name an existing reverted commit
+    with a "This reverts commit <sha>." line.
+
+  - ScopePaths: Checks that the scope of a conventional commit covers the files the
+    commit changes, using a mapping of scopes to paths.
+
   - SignOff: Ensures commits include a valid Developer Certificate of Origin (DCO)
-    sign-off line.
+    sign-off line, optionally matching the author, committer and co-authors.
 
   - Spell: Checks for common misspellings in commit messages with locale-specific
     dictionaries.
@@ -38,12 +60,28 @@ Commit Message Content Rules:
   - SubjectLength: Limits the character length of commit subject lines to improve
     readability.
 
+  - TypeContent: Checks that the type of a conventional commit matches the kind of
+    files it changes, such as docs, tests, CI pipelines or build files.
+
+  - BodyLineLength: Limits the length of commit body lines, exempting lines that
+    cannot be wrapped such as URLs, code blocks, quotes and trailers.
+
   - SubjectSuffix: Prevents commit subjects from ending with specified characters
     like periods or commas.
 
+  - Trailers: Validates the trailer block at the end of the message, such as
+    Reviewed-by or Change-Id, against required, forbidden, pattern, count and
+    order policies.
+
   - JiraReference: Validates that commits reference Jira issue keys in a consistent
     format, with optional project validation.
 
+  - IssueReference: Requires a reference to an issue of a configured tracker, such
+    as a Jira key or a GitHub issue, in an allowed place of the commit message.
+
+  - IssueStatus: Verifies with the tracker's API that referenced issues exist and
+    have an allowed status, warning instead of failing when the tracker is offline.
+
 Security Rules:
 
   - Signature: Verifies commits have a cryptographic signature (GPG or SSH).
@@ -51,11 +89,42 @@ Security Rules:
   - SignedIdentity: Validates commit signatures against trusted keys with
     cryptographic verification.
 
+  - SensitiveContent: Detects secrets such as keys, tokens and high-entropy strings
+    in commit messages, and redacts them in all output.
+
 Git State Rules:
 
   - CommitsAhead: Limits how far a branch can diverge from a reference branch to
     reduce merge complexity.
 
+  - MergePolicy: Enforces a policy on merge commits: a linear history, merges from
+    the base branch only, or merge subjects matching a template.
+
+  - CommitSize: Limits the number of files and lines a commit changes and the size
+    of the files it adds, and optionally rejects binary files.
+
+Tag Rules:
+
+  - AnnotatedTag: Rejects lightweight tags, which carry no tagger, message or
+    signature.
+
+  - TagMessage: Ensures annotated tags have a non-empty message.
+
+  - TagName: Enforces a tag naming scheme, by default semantic versioning.
+
+Branch Rules:
+
+  - BranchName: Enforces a branch naming convention, optionally with a Jira key
+    that matches the keys referenced in the commit subjects.
+
+Custom Rules:
+
+  - CustomRule: Checks the subject, body, trailers, author or message against
+    regular expressions declared in the configuration, under a configured name.
+  - ExpressionRule: Evaluates a boolean CEL policy expression declared in the
+    configuration over the commit's subject, conventional parts, trailers, author,
+    changed paths and number of parents.
+
 Each rule provides detailed help and error messages designed to guide users toward
 fixing issues in their commit messages or repository state. The error messages
 include examples and step-by-step instructions for resolving the most common
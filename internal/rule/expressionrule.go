@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule
+
+import (
+	"fmt"
+
+	"github.com/itiquette/gommitlint/internal/expression"
+	"github.com/itiquette/gommitlint/internal/model"
+)
+
+// ExpressionRuleSpec declares a rule that checks a commit with a policy expression.
+type ExpressionRuleSpec struct {
+	// Name is the rule name shown in reports
+	Name string
+
+	// Expression is the policy, a CEL expression that is true if the commit satisfies it
+	Expression string
+
+	// Program is the compiled expression; the expression is compiled if it is nil
+	Program *expression.Program
+
+	// Message is the result shown when the rule fails
+	Message string
+
+	// Help describes how to fix a failure
+	Help string
+}
+
+// ExpressionRule is a policy expression declared in the configuration.
+//
+// Some policies combine several parts of a commit, such as "a feature in the api scope
+// must reference an issue", which regular expressions cannot express. An expression rule
+// evaluates a boolean CEL expression over the commit: its subject, conventional commit
+// parts, trailers, author, changed paths and number of parents.
+//
+// A commit fails the rule if the expression is false, or if it cannot be evaluated,
+// e.g. because it indexes a trailer the commit does not have.
+//
+// Examples:
+//
+//   - With the expression `commit.type != "feat" || "Refs" in commit.trailers`:
+//     "feat: add login" ending in "Refs: #12" would pass
+//     "feat: add login" without a Refs trailer would fail
+//     "fix: handle empty password" would pass
+type ExpressionRule struct {
+	spec   ExpressionRuleSpec
+	errors []*model.ValidationError
+}
+
+// Name returns the rule name.
+func (rule ExpressionRule) Name() string {
+	return rule.spec.Name
+}
+
+// Result returns a concise validation result.
+func (rule ExpressionRule) Result() string {
+	if len(rule.errors) == 0 {
+		return "Passed"
+	}
+
+	if rule.spec.Message != "" && rule.errors[0].Code == "policy_violated" {
+		return rule.spec.Message
+	}
+
+	return "Failed"
+}
+
+// VerboseResult returns a more detailed explanation for verbose mode.
+func (rule ExpressionRule) VerboseResult() string {
+	if len(rule.errors) == 0 {
+		return fmt.Sprintf("The commit satisfies '%s'", rule.spec.Expression)
+	}
+
+	switch rule.errors[0].Code {
+	case "policy_violated":
+		return fmt.Sprintf("The commit does not satisfy '%s'", rule.spec.Expression)
+	case "evaluation_failed":
+		return fmt.Sprintf("Could not evaluate '%s': %s", rule.spec.Expression, rule.errors[0].Context["error"])
+	default:
+		return rule.errors[0].Error()
+	}
+}
+
+// addError adds a structured validation error.
+func (rule *ExpressionRule) addError(code, message string, context map[string]string) {
+	err := model.NewValidationError(rule.spec.Name, code, message)
+
+	// Add any context values
+	for key, value := range context {
+		_ = err.WithContext(key, value)
+	}
+
+	rule.errors = append(rule.errors, err)
+}
+
+// Errors returns validation errors.
+func (rule ExpressionRule) Errors() []*model.ValidationError {
+	return rule.errors
+}
+
+// Help returns a description of how to fix the rule violation.
+func (rule ExpressionRule) Help() string {
+	if len(rule.errors) == 0 {
+		return "No errors to fix"
+	}
+
+	switch rule.errors[0].Code {
+	case "invalid_expression":
+		return fmt.Sprintf("Fix the expression of expression rule '%s': %s",
+			rule.spec.Name, rule.errors[0].Context["error"])
+
+	case "evaluation_failed":
+		return fmt.Sprintf(`Change the expression of expression rule '%s' so that it can be evaluated for every commit.
+Check that a trailer exists before using it, e.g. '"Refs" in commit.trailers && ...'.`, rule.spec.Name)
+	}
+
+	if rule.spec.Help != "" {
+		return rule.spec.Help
+	}
+
+	return fmt.Sprintf("Change the commit so that it satisfies '%s'.", rule.spec.Expression)
+}
+
+// ValidateExpressionRule evaluates a policy expression against a commit.
+//
+// Parameters:
+//   - spec: The rule declaration
+//   - commit: The commit data the expression sees
+//
+// Returns:
+//   - An ExpressionRule instance with validation results
+func ValidateExpressionRule(spec ExpressionRuleSpec, commit expression.Commit) *ExpressionRule {
+	rule := &ExpressionRule{spec: spec}
+
+	program := spec.Program
+	if program == nil {
+		var err error
+
+		program, err = expression.Compile(spec.Expression)
+		if err != nil {
+			rule.addError(
+				"invalid_expression",
+				fmt.Sprintf("invalid expression in expression rule %s: %s", spec.Name, err),
+				map[string]string{
+					"expression": spec.Expression,
+					"error":      err.Error(),
+				},
+			)
+
+			return rule
+		}
+	}
+
+	satisfied, err := program.Eval(commit)
+	if err != nil {
+		rule.addError(
+			"evaluation_failed",
+			fmt.Sprintf("could not evaluate expression rule %s: %s", spec.Name, err),
+			map[string]string{
+				"expression": spec.Expression,
+				"error":      err.Error(),
+			},
+		)
+
+		return rule
+	}
+
+	if !satisfied {
+		rule.addError(
+			"policy_violated",
+			fmt.Sprintf("commit does not satisfy %s", spec.Expression),
+			map[string]string{
+				"expression": spec.Expression,
+			},
+		)
+	}
+
+	return rule
+}
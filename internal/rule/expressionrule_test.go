@@ -0,0 +1,114 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/expression"
+	"github.com/itiquette/gommitlint/internal/rule"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateExpressionRule(t *testing.T) {
+	const featuresReferenceIssues = `!(commit.type == "feat" && "api" in commit.scopes) || "Refs" in commit.trailers`
+
+	tests := []struct {
+		name       string
+		expression string
+		commit     expression.Commit
+		wantCodes  []string
+	}{
+		{
+			name:       "feature with reference",
+			expression: featuresReferenceIssues,
+			commit: expression.Commit{
+				Type:     "feat",
+				Scopes:   []string{"api"},
+				Trailers: map[string][]string{"Refs": {"#12"}},
+			},
+		},
+		{
+			name:       "feature without reference",
+			expression: featuresReferenceIssues,
+			commit:     expression.Commit{Type: "feat", Scopes: []string{"api"}},
+			wantCodes:  []string{"policy_violated"},
+		},
+		{
+			name:       "fix without reference",
+			expression: featuresReferenceIssues,
+			commit:     expression.Commit{Type: "fix", Scopes: []string{"api"}},
+		},
+		{
+			name:       "merge commit",
+			expression: `commit.parent_count < 2`,
+			commit:     expression.Commit{ParentCount: 2},
+			wantCodes:  []string{"policy_violated"},
+		},
+		{
+			name:       "missing trailer",
+			expression: `commit.trailers["Tested"].size() > 0`,
+			commit:     expression.Commit{},
+			wantCodes:  []string{"evaluation_failed"},
+		},
+		{
+			name:       "invalid expression",
+			expression: `commit.kind == "feat"`,
+			wantCodes:  []string{"invalid_expression"},
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			result := rule.ValidateExpressionRule(rule.ExpressionRuleSpec{
+				Name:       "TeamPolicy",
+				Expression: tabletest.expression,
+			}, tabletest.commit)
+
+			require.Equal(t, "TeamPolicy", result.Name())
+
+			codes := make([]string, 0, len(result.Errors()))
+			for _, err := range result.Errors() {
+				codes = append(codes, err.Code)
+				require.Equal(t, "TeamPolicy", err.Rule)
+			}
+
+			if len(tabletest.wantCodes) == 0 {
+				require.Empty(t, codes)
+				require.Equal(t, "Passed", result.Result())
+				require.Equal(t, "No errors to fix", result.Help())
+
+				return
+			}
+
+			require.Equal(t, tabletest.wantCodes, codes)
+			require.Equal(t, "Failed", result.Result())
+			require.NotEmpty(t, result.VerboseResult())
+			require.NotEmpty(t, result.Help())
+		})
+	}
+}
+
+func TestExpressionRuleMessageAndHelp(t *testing.T) {
+	program, err := expression.Compile(`!commit.subject.contains("[skip ci]")`)
+	require.NoError(t, err)
+
+	spec := rule.ExpressionRuleSpec{
+		Name:       "NoSkipCI",
+		Expression: program.String(),
+		Program:    program,
+		Message:    "Do not skip CI on main",
+		Help:       "Remove [skip ci] from the subject.",
+	}
+
+	result := rule.ValidateExpressionRule(spec, expression.Commit{Subject: "Fix crash [skip ci]"})
+	require.Equal(t, "Do not skip CI on main", result.Result())
+	require.Equal(t, `The commit does not satisfy '!commit.subject.contains("[skip ci]")'`, result.VerboseResult())
+	require.Equal(t, "Remove [skip ci] from the subject.", result.Help())
+
+	spec.Help = ""
+	result = rule.ValidateExpressionRule(spec, expression.Commit{Subject: "Fix crash [skip ci]"})
+	require.Equal(t, `Change the commit so that it satisfies '!commit.subject.contains("[skip ci]")'.`, result.Help())
+}
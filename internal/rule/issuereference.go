@@ -0,0 +1,453 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/itiquette/gommitlint/internal/model"
+)
+
+// Placements of issue references in a commit message.
+const (
+	// PlacementSubjectEnd is a reference at the end of the subject, e.g. "fix: crash (#12)".
+	PlacementSubjectEnd = "subject-end"
+
+	// PlacementSubjectPrefix is a reference at the start of the subject, e.g. "[PROJ-1] Fix crash".
+	PlacementSubjectPrefix = "subject-prefix"
+
+	// PlacementTrailer is a reference in a "Refs:" or closing keyword line, e.g. "Fixes #12".
+	PlacementTrailer = "trailer"
+)
+
+// refsToken is the trailer token of references that do not close the issue.
+const refsToken = "Refs"
+
+// jiraKeyPattern matches a Jira issue key such as PROJ-123. It is shared by the jira
+// tracker profile and the JiraReference rule.
+const jiraKeyPattern = `(?P<project>[A-Z]+)-(?P<id>\d+)`
+
+// githubClosingKeywords are the keywords GitHub closes issues with.
+var githubClosingKeywords = []string{"Close", "Closes", "Closed", "Fix", "Fixes", "Fixed", "Resolve", "Resolves", "Resolved"}
+
+// trailerReferenceRegex matches a line that may hold references: "Token: refs" or "Token refs".
+var trailerReferenceRegex = regexp.MustCompile(`^([A-Za-z][\w-]*):?\s+(.+)$`)
+
+// IssueTracker describes the references of one issue tracker.
+type IssueTracker struct {
+	// Name identifies the tracker, e.g. "github"
+	Name string
+
+	// Pattern is a regular expression of a reference, with the named groups "id" and
+	// optionally "project"
+	Pattern string
+
+	// Placements lists where references may appear (PlacementSubjectEnd, PlacementSubjectPrefix, PlacementTrailer)
+	Placements []string
+
+	// ClosingKeywords lists trailer tokens that close the referenced issue, e.g. "Fixes"
+	ClosingKeywords []string
+
+	// Projects lists the allowed projects, e.g. Jira keys or "owner/repo" (empty allows all)
+	Projects []string
+}
+
+// DefaultIssueTrackers are the built-in tracker profiles, by name.
+var DefaultIssueTrackers = map[string]IssueTracker{
+	"jira": {
+		Name:       "jira",
+		Pattern:    jiraKeyPattern,
+		Placements: []string{PlacementSubjectEnd, PlacementTrailer},
+	},
+	"github": {
+		Name:            "github",
+		Pattern:         `(?:(?P<project>[\w.-]+/[\w.-]+))?#(?P<id>\d+)`,
+		Placements:      []string{PlacementSubjectEnd, PlacementTrailer},
+		ClosingKeywords: githubClosingKeywords,
+	},
+	"gitlab": {
+		Name:            "gitlab",
+		Pattern:         `(?:(?P<project>[\w.-]+(?:/[\w.-]+)+))?[#!](?P<id>\d+)`,
+		Placements:      []string{PlacementSubjectEnd, PlacementTrailer},
+		ClosingKeywords: append([]string{"Implement", "Implements", "Implemented"}, githubClosingKeywords...),
+	},
+	"azure-boards": {
+		Name:            "azure-boards",
+		Pattern:         `AB#(?P<id>\d+)`,
+		Placements:      []string{PlacementSubjectEnd, PlacementTrailer},
+		ClosingKeywords: []string{"Fix", "Fixes", "Fixed"},
+	},
+	"linear": {
+		Name:            "linear",
+		Pattern:         `(?P<project>[A-Z][A-Z0-9]*)-(?P<id>\d+)`,
+		Placements:      []string{PlacementSubjectEnd, PlacementSubjectPrefix, PlacementTrailer},
+		ClosingKeywords: []string{"Close", "Closes", "Fix", "Fixes", "Resolve", "Resolves", "Complete", "Completes"},
+	},
+}
+
+// IssueRef is an issue reference found in a commit message.
+type IssueRef struct {
+	Tracker   string // Name of the tracker the reference belongs to
+	Text      string // Reference as written, e.g. "owner/repo#45"
+	Project   string // Project part, empty if the reference has none
+	ID        string // Issue number or key part
+	Placement string // Where the reference appears
+	Closing   bool   // Whether a closing keyword introduces the reference
+}
+
+// IssueReferenceConfig provides configuration for the IssueReference rule.
+type IssueReferenceConfig struct {
+	// Trackers lists the issue trackers whose references are accepted
+	Trackers []IssueTracker
+}
+
+// IssueReferenceOption configures an IssueReferenceConfig.
+type IssueReferenceOption func(*IssueReferenceConfig)
+
+// WithIssueTrackers adds issue trackers whose references are accepted.
+func WithIssueTrackers(trackers ...IssueTracker) IssueReferenceOption {
+	return func(c *IssueReferenceConfig) {
+		c.Trackers = append(c.Trackers, trackers...)
+	}
+}
+
+// IssueReference enforces references to issues of one or more issue trackers.
+//
+// Each tracker profile defines what a reference looks like, where it may appear, which
+// trailer keywords close the issue and which projects are allowed. Built-in profiles
+// exist for Jira (PROJ-123), GitHub (#123, owner/repo#45), GitLab (#12, group/project!12),
+// Azure Boards (AB#123) and Linear (ENG-123). A reference may appear:
+//
+//   - at the end of the subject, optionally in brackets: "fix: handle timeouts (#12)"
+//   - at the start of the subject: "[PROJ-123] Handle timeouts"
+//   - in a trailer line: "Refs: PROJ-123, PROJ-124" or with a closing keyword "Fixes #12"
+//
+// The rule requires at least one reference in an allowed placement, and every reference
+// to name an allowed project.
+//
+// Examples:
+//
+//   - With the github profile:
+//     "fix: handle timeouts (#12)" would pass
+//     "fix: handle timeouts" with "Closes owner/repo#45" in the body would pass
+//     "fix #12 by handling timeouts" would fail (reference not in an allowed placement)
+type IssueReference struct {
+	references     []IssueRef
+	trackers       []string
+	placements     []string
+	invalidPattern string
+	errors         []*model.ValidationError
+}
+
+// Name returns the rule name.
+func (rule IssueReference) Name() string {
+	return "IssueReference"
+}
+
+// Result returns a concise validation result.
+func (rule IssueReference) Result() string {
+	if len(rule.errors) > 0 {
+		return "Missing or invalid issue reference"
+	}
+
+	return "Valid issue reference"
+}
+
+// VerboseResult returns a more detailed explanation for verbose mode.
+func (rule IssueReference) VerboseResult() string {
+	if len(rule.errors) > 0 {
+		switch rule.errors[0].Code {
+		case "invalid_pattern":
+			return fmt.Sprintf("Pattern '%s' of tracker '%s' is not a valid regular expression with an 'id' group.",
+				rule.invalidPattern, rule.errors[0].Context["tracker"])
+		case "missing_reference":
+			return fmt.Sprintf("No %s issue reference found. Allowed placements: %s",
+				strings.Join(rule.trackers, " or "), strings.Join(rule.placements, ", "))
+		case "misplaced_reference":
+			return fmt.Sprintf("Reference %s is not in an allowed placement: %s",
+				rule.errors[0].Context["reference"], strings.Join(rule.placements, ", "))
+		case "unknown_project":
+			return fmt.Sprintf("Reference %s names project '%s', allowed projects are: %s",
+				rule.errors[0].Context["reference"], rule.errors[0].Context["project"],
+				strings.ReplaceAll(rule.errors[0].Context["projects"], ",", ", "))
+		default:
+			return rule.errors[0].Error()
+		}
+	}
+
+	texts := make([]string, 0, len(rule.references))
+
+	for _, ref := range rule.references {
+		if ref.Closing {
+			texts = append(texts, ref.Text+" (closes)")
+		} else {
+			texts = append(texts, ref.Text)
+		}
+	}
+
+	return "Found issue references: " + strings.Join(texts, ", ")
+}
+
+// addError adds a structured validation error.
+func (rule *IssueReference) addError(code, message string, context map[string]string) {
+	err := model.NewValidationError("IssueReference", code, message)
+
+	// Add any context values
+	for key, value := range context {
+		_ = err.WithContext(key, value)
+	}
+
+	rule.errors = append(rule.errors, err)
+}
+
+// Errors returns validation errors.
+func (rule IssueReference) Errors() []*model.ValidationError {
+	return rule.errors
+}
+
+// References returns the issue references found in allowed placements.
+func (rule IssueReference) References() []IssueRef {
+	return rule.references
+}
+
+// Help returns a description of how to fix the rule violation.
+func (rule IssueReference) Help() string {
+	if len(rule.errors) == 0 {
+		return "No errors to fix"
+	}
+
+	switch rule.errors[0].Code {
+	case "invalid_pattern":
+		return `Fix the pattern of the tracker in 'issue-reference.trackers'. It must be a valid
+Go regular expression with a named group for the issue number, e.g.:
+
+  pattern: 'TICKET-(?P<id>\d+)'`
+
+	case "missing_reference", "misplaced_reference":
+		return fmt.Sprintf(`Reference the issue this commit belongs to (%s) in one of these places: %s.
+
+Examples:
+  fix: handle timeouts (#12)
+  [PROJ-123] Handle timeouts
+
+or in a trailer at the end of the message:
+  Refs: PROJ-123
+  Fixes #12`, strings.Join(rule.trackers, ", "), strings.Join(rule.placements, ", "))
+
+	case "unknown_project":
+		return fmt.Sprintf(`Reference an issue of one of the allowed projects: %s.
+Check the reference for typos, or add the project to 'issue-reference.trackers'.`,
+			strings.ReplaceAll(rule.errors[0].Context["projects"], ",", ", "))
+	}
+
+	return rule.errors[0].Message
+}
+
+// ValidateIssueReference checks the issue references of a commit message.
+//
+// Parameters:
+//   - subject: The commit subject line
+//   - body: The commit body
+//   - opts: Options with the issue trackers to accept
+//
+// Returns:
+//   - An IssueReference instance with validation results
+func ValidateIssueReference(subject, body string, opts ...IssueReferenceOption) *IssueReference {
+	var config IssueReferenceConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	rule := &IssueReference{}
+	regexes := make([]*regexp.Regexp, 0, len(config.Trackers))
+
+	for _, tracker := range config.Trackers {
+		trackerRegex, err := regexp.Compile(tracker.Pattern)
+		if err == nil && trackerRegex.SubexpIndex("id") < 0 {
+			err = fmt.Errorf("pattern has no named group %q", "id")
+		}
+
+		if err != nil || tracker.Pattern == "" {
+			message := fmt.Sprintf("tracker %s has no pattern", tracker.Name)
+			if err != nil {
+				message = fmt.Sprintf("invalid pattern %q of tracker %s: %s", tracker.Pattern, tracker.Name, err)
+			}
+
+			rule.invalidPattern = tracker.Pattern
+			rule.addError(
+				"invalid_pattern",
+				message,
+				map[string]string{
+					"tracker": tracker.Name,
+					"pattern": tracker.Pattern,
+				},
+			)
+
+			return rule
+		}
+
+		regexes = append(regexes, trackerRegex)
+		rule.trackers = append(rule.trackers, tracker.Name)
+
+		for _, placement := range tracker.Placements {
+			if !slices.Contains(rule.placements, placement) {
+				rule.placements = append(rule.placements, placement)
+			}
+		}
+	}
+
+	subject = strings.TrimSpace(subject)
+
+	var misplaced []IssueRef
+
+	for index, tracker := range config.Trackers {
+		for _, ref := range findIssueRefs(tracker, regexes[index], subject, body) {
+			if slices.Contains(tracker.Placements, ref.Placement) {
+				rule.references = append(rule.references, ref)
+			} else {
+				misplaced = append(misplaced, ref)
+			}
+		}
+	}
+
+	if len(rule.references) == 0 {
+		if len(misplaced) > 0 {
+			rule.addError(
+				"misplaced_reference",
+				fmt.Sprintf("issue reference %s is not in an allowed placement", misplaced[0].Text),
+				map[string]string{
+					"reference":  misplaced[0].Text,
+					"placements": strings.Join(rule.placements, ","),
+				},
+			)
+
+			return rule
+		}
+
+		rule.addError(
+			"missing_reference",
+			"no issue reference found",
+			map[string]string{
+				"trackers":   strings.Join(rule.trackers, ","),
+				"placements": strings.Join(rule.placements, ","),
+			},
+		)
+
+		return rule
+	}
+
+	for _, ref := range rule.references {
+		tracker := config.Trackers[slices.IndexFunc(config.Trackers, func(t IssueTracker) bool { return t.Name == ref.Tracker })]
+		if ref.Project == "" || len(tracker.Projects) == 0 || slices.Contains(tracker.Projects, ref.Project) {
+			continue
+		}
+
+		rule.addError(
+			"unknown_project",
+			fmt.Sprintf("issue reference %s names unknown project %s", ref.Text, ref.Project),
+			map[string]string{
+				"reference": ref.Text,
+				"project":   ref.Project,
+				"projects":  strings.Join(tracker.Projects, ","),
+			},
+		)
+	}
+
+	return rule
+}
+
+// findIssueRefs returns the references of a tracker in the subject and the reference trailers of the body.
+// References in the subject that are neither at its start nor at its end have no placement.
+func findIssueRefs(tracker IssueTracker, trackerRegex *regexp.Regexp, subject, body string) []IssueRef {
+	var refs []IssueRef
+
+	subjectMatches := findReferenceMatches(trackerRegex, subject)
+	for index, loc := range subjectMatches {
+		ref := newIssueRef(tracker, trackerRegex, subject, loc)
+
+		switch {
+		case index == 0 && isSubjectPrefix(subject[:loc[0]]):
+			ref.Placement = PlacementSubjectPrefix
+		case index == len(subjectMatches)-1 && isSubjectSuffix(subject[loc[1]:]):
+			ref.Placement = PlacementSubjectEnd
+		}
+
+		refs = append(refs, ref)
+	}
+
+	for _, line := range strings.Split(body, "\n") {
+		match := trailerReferenceRegex.FindStringSubmatch(strings.TrimSpace(line))
+		if match == nil {
+			continue
+		}
+
+		closing := slices.ContainsFunc(tracker.ClosingKeywords, func(keyword string) bool {
+			return strings.EqualFold(keyword, match[1])
+		})
+		if !closing && !strings.EqualFold(match[1], refsToken) {
+			continue
+		}
+
+		for _, loc := range findReferenceMatches(trackerRegex, match[2]) {
+			ref := newIssueRef(tracker, trackerRegex, match[2], loc)
+			ref.Placement = PlacementTrailer
+			ref.Closing = closing
+			refs = append(refs, ref)
+		}
+	}
+
+	return refs
+}
+
+// findReferenceMatches returns the submatch indexes of the references in text.
+// A reference must not continue a word, so that "AB#12" is not read as the GitHub reference "#12".
+func findReferenceMatches(trackerRegex *regexp.Regexp, text string) [][]int {
+	var locs [][]int
+
+	for _, loc := range trackerRegex.FindAllStringSubmatchIndex(text, -1) {
+		if loc[0] > 0 {
+			previous, _ := utf8.DecodeLastRuneInString(text[:loc[0]])
+			if unicode.IsLetter(previous) || unicode.IsDigit(previous) || previous == '_' {
+				continue
+			}
+		}
+
+		locs = append(locs, loc)
+	}
+
+	return locs
+}
+
+// newIssueRef creates the reference for a match of the tracker pattern.
+func newIssueRef(tracker IssueTracker, trackerRegex *regexp.Regexp, text string, loc []int) IssueRef {
+	ref := IssueRef{Tracker: tracker.Name, Text: text[loc[0]:loc[1]]}
+
+	if group := trackerRegex.SubexpIndex("id"); loc[2*group] >= 0 {
+		ref.ID = text[loc[2*group]:loc[2*group+1]]
+	}
+
+	if group := trackerRegex.SubexpIndex("project"); group >= 0 && loc[2*group] >= 0 {
+		ref.Project = text[loc[2*group]:loc[2*group+1]]
+	}
+
+	return ref
+}
+
+// isSubjectPrefix reports whether the text before a reference allows it to be the subject prefix,
+// e.g. "" or "[".
+func isSubjectPrefix(before string) bool {
+	return strings.Trim(before, "[( ") == ""
+}
+
+// isSubjectSuffix reports whether the text after a reference allows it to end the subject,
+// e.g. "", "]" or ")".
+func isSubjectSuffix(after string) bool {
+	return strings.Trim(after, "]) ") == ""
+}
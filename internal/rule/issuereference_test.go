@@ -0,0 +1,194 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/rule"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateIssueReference(t *testing.T) {
+	jira := rule.DefaultIssueTrackers["jira"]
+	github := rule.DefaultIssueTrackers["github"]
+	gitlab := rule.DefaultIssueTrackers["gitlab"]
+	azure := rule.DefaultIssueTrackers["azure-boards"]
+	linear := rule.DefaultIssueTrackers["linear"]
+
+	jiraProjects := jira
+	jiraProjects.Projects = []string{"PROJ", "OPS"}
+
+	githubRepos := github
+	githubRepos.Projects = []string{"itiquette/gommitlint"}
+
+	trailerOnly := github
+	trailerOnly.Placements = []string{rule.PlacementTrailer}
+
+	tests := []struct {
+		name      string
+		subject   string
+		body      string
+		trackers  []rule.IssueTracker
+		wantCodes []string
+		wantRefs  []string
+	}{
+		{
+			name:     "Jira key at subject end",
+			subject:  "feat: add login PROJ-123",
+			trackers: []rule.IssueTracker{jira},
+			wantRefs: []string{"PROJ-123"},
+		},
+		{
+			name:     "Jira key in Refs trailer",
+			subject:  "feat: add login",
+			body:     "Adds the login form.\n\nRefs: PROJ-123, PROJ-124",
+			trackers: []rule.IssueTracker{jira},
+			wantRefs: []string{"PROJ-123", "PROJ-124"},
+		},
+		{
+			name:     "GitHub issue in brackets",
+			subject:  "fix: handle timeouts (#12)",
+			trackers: []rule.IssueTracker{github},
+			wantRefs: []string{"#12"},
+		},
+		{
+			name:     "GitHub closing keyword for another repository",
+			subject:  "fix: handle timeouts",
+			body:     "Closes itiquette/gommitlint#45",
+			trackers: []rule.IssueTracker{githubRepos},
+			wantRefs: []string{"itiquette/gommitlint#45"},
+		},
+		{
+			name:      "GitHub repository outside allowlist",
+			subject:   "fix: handle timeouts",
+			body:      "Fixes other/repo#45",
+			trackers:  []rule.IssueTracker{githubRepos},
+			wantCodes: []string{"unknown_project"},
+		},
+		{
+			name:     "GitLab merge request",
+			subject:  "fix: handle timeouts",
+			body:     "Refs: group/project!12",
+			trackers: []rule.IssueTracker{gitlab},
+			wantRefs: []string{"group/project!12"},
+		},
+		{
+			name:     "Azure Boards work item",
+			subject:  "Handle timeouts AB#123",
+			trackers: []rule.IssueTracker{azure, github},
+			wantRefs: []string{"AB#123"},
+		},
+		{
+			name:     "Linear key as subject prefix",
+			subject:  "[ENG-42] Handle timeouts",
+			trackers: []rule.IssueTracker{linear},
+			wantRefs: []string{"ENG-42"},
+		},
+		{
+			name:      "Jira key as subject prefix is not allowed by default",
+			subject:   "PROJ-123: add login",
+			trackers:  []rule.IssueTracker{jira},
+			wantCodes: []string{"misplaced_reference"},
+		},
+		{
+			name:      "reference in the middle of the subject",
+			subject:   "fix #12 by handling timeouts",
+			trackers:  []rule.IssueTracker{github},
+			wantCodes: []string{"misplaced_reference"},
+		},
+		{
+			name:      "subject reference when only trailers are allowed",
+			subject:   "fix: handle timeouts (#12)",
+			trackers:  []rule.IssueTracker{trailerOnly},
+			wantCodes: []string{"misplaced_reference"},
+		},
+		{
+			name:      "unknown trailer token",
+			subject:   "fix: handle timeouts",
+			body:      "See #12",
+			trackers:  []rule.IssueTracker{github},
+			wantCodes: []string{"missing_reference"},
+		},
+		{
+			name:      "no reference",
+			subject:   "fix: handle timeouts",
+			trackers:  []rule.IssueTracker{jira, github},
+			wantCodes: []string{"missing_reference"},
+		},
+		{
+			name:      "Jira project outside allowlist",
+			subject:   "feat: add login ABC-1",
+			trackers:  []rule.IssueTracker{jiraProjects},
+			wantCodes: []string{"unknown_project"},
+		},
+		{
+			name:      "custom tracker without pattern",
+			subject:   "fix: handle timeouts",
+			trackers:  []rule.IssueTracker{{Name: "redmine", Placements: []string{rule.PlacementTrailer}}},
+			wantCodes: []string{"invalid_pattern"},
+		},
+		{
+			name:      "custom tracker without id group",
+			subject:   "fix: handle timeouts",
+			trackers:  []rule.IssueTracker{{Name: "redmine", Pattern: `RM\d+`}},
+			wantCodes: []string{"invalid_pattern"},
+		},
+		{
+			name:    "custom tracker",
+			subject: "fix: handle timeouts",
+			body:    "Refs: RM42",
+			trackers: []rule.IssueTracker{{
+				Name:       "redmine",
+				Pattern:    `RM(?P<id>\d+)`,
+				Placements: []string{rule.PlacementTrailer},
+			}},
+			wantRefs: []string{"RM42"},
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			result := rule.ValidateIssueReference(tabletest.subject, tabletest.body,
+				rule.WithIssueTrackers(tabletest.trackers...))
+
+			codes := make([]string, 0, len(result.Errors()))
+			for _, err := range result.Errors() {
+				codes = append(codes, err.Code)
+			}
+
+			if len(tabletest.wantCodes) == 0 {
+				require.Empty(t, codes)
+				require.Equal(t, "Valid issue reference", result.Result())
+				require.Equal(t, "No errors to fix", result.Help())
+
+				refs := make([]string, 0, len(result.References()))
+				for _, ref := range result.References() {
+					refs = append(refs, ref.Text)
+				}
+
+				require.Equal(t, tabletest.wantRefs, refs)
+
+				return
+			}
+
+			require.Equal(t, tabletest.wantCodes, codes)
+			require.Equal(t, "Missing or invalid issue reference", result.Result())
+			require.NotEmpty(t, result.VerboseResult())
+			require.NotEmpty(t, result.Help())
+		})
+	}
+}
+
+func TestIssueReferenceReferences(t *testing.T) {
+	result := rule.ValidateIssueReference("fix: handle timeouts", "Fixes itiquette/gommitlint#45\nRefs: #7",
+		rule.WithIssueTrackers(rule.DefaultIssueTrackers["github"]))
+
+	require.Equal(t, []rule.IssueRef{
+		{Tracker: "github", Text: "itiquette/gommitlint#45", Project: "itiquette/gommitlint", ID: "45", Placement: rule.PlacementTrailer, Closing: true},
+		{Tracker: "github", Text: "#7", ID: "7", Placement: rule.PlacementTrailer},
+	}, result.References())
+	require.Equal(t, "Found issue references: itiquette/gommitlint#45 (closes), #7", result.VerboseResult())
+}
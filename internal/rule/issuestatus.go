@@ -0,0 +1,331 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/model"
+)
+
+// IssueState is the state of an issue as reported by its tracker.
+type IssueState struct {
+	Exists bool   // Whether the issue exists
+	Status string // Status of the issue, e.g. "open" or "In Progress"
+}
+
+// IssueStatusConfig provides configuration for the IssueStatus rule.
+type IssueStatusConfig struct {
+	// Lookup returns the state of a referenced issue, or an error if it cannot be looked up
+	Lookup func(ref IssueRef) (IssueState, error)
+
+	// Unreachable reports whether a lookup error means that the tracker could not be reached
+	Unreachable func(err error) bool
+
+	// Statuses maps tracker names to their allowed statuses (no entry allows all)
+	Statuses map[string][]string
+
+	// ForbiddenStatuses maps tracker names to statuses issues must not have, e.g. "closed"
+	ForbiddenStatuses map[string][]string
+}
+
+// IssueStatusOption configures an IssueStatusConfig.
+type IssueStatusOption func(*IssueStatusConfig)
+
+// WithIssueLookup sets the function that looks up the state of a referenced issue.
+func WithIssueLookup(lookup func(ref IssueRef) (IssueState, error)) IssueStatusOption {
+	return func(c *IssueStatusConfig) {
+		c.Lookup = lookup
+	}
+}
+
+// WithUnreachableCheck sets the function that reports whether a lookup error means that
+// the tracker could not be reached. Only such errors are reported as warnings.
+func WithUnreachableCheck(unreachable func(err error) bool) IssueStatusOption {
+	return func(c *IssueStatusConfig) {
+		c.Unreachable = unreachable
+	}
+}
+
+// WithAllowedStatuses sets the allowed statuses of the issues of a tracker, compared
+// case-insensitively.
+func WithAllowedStatuses(tracker string, statuses []string) IssueStatusOption {
+	return func(c *IssueStatusConfig) {
+		if len(statuses) == 0 {
+			return
+		}
+
+		if c.Statuses == nil {
+			c.Statuses = make(map[string][]string)
+		}
+
+		c.Statuses[tracker] = statuses
+	}
+}
+
+// WithForbiddenStatuses sets the statuses the issues of a tracker must not have, compared
+// case-insensitively. Unlike the allowed statuses, they need not list every open status.
+func WithForbiddenStatuses(tracker string, statuses []string) IssueStatusOption {
+	return func(c *IssueStatusConfig) {
+		if len(statuses) == 0 {
+			return
+		}
+
+		if c.ForbiddenStatuses == nil {
+			c.ForbiddenStatuses = make(map[string][]string)
+		}
+
+		c.ForbiddenStatuses[tracker] = statuses
+	}
+}
+
+// IssueStatus verifies that referenced issues exist and have an allowed status.
+//
+// A reference like PROJ-99999 matches the pattern of its tracker even when the issue
+// does not exist, and a commit may reference an issue that was closed long ago. This
+// rule looks up each reference found by the IssueReference rule in its tracker.
+//
+// When the tracker cannot be reached, the rule reports a warning instead of an error,
+// so that working offline does not block commits. Other lookup errors, such as a rejected
+// token or a reference the API cannot resolve, are errors.
+//
+// Examples:
+//
+//   - With the allowed statuses "open":
+//     "Fixes #12" would pass if issue 12 is open
+//     "Fixes #12" would fail if issue 12 is closed or does not exist
+//
+//   - With the forbidden statuses "Closed":
+//     "PROJ-1 fix cache" would pass if PROJ-1 is in any other status
+//     "PROJ-1 fix cache" would fail if PROJ-1 is closed
+type IssueStatus struct {
+	checked []string
+	errors  []*model.ValidationError
+}
+
+// Name returns the rule name.
+func (rule IssueStatus) Name() string {
+	return "IssueStatus"
+}
+
+// Result returns a concise validation result.
+func (rule IssueStatus) Result() string {
+	if model.Failed(rule) {
+		return "Referenced issue not found or not in an allowed status"
+	}
+
+	if len(rule.errors) > 0 {
+		return "Referenced issues not verified"
+	}
+
+	return "Referenced issues exist"
+}
+
+// VerboseResult returns a more detailed explanation for verbose mode.
+func (rule IssueStatus) VerboseResult() string {
+	if len(rule.errors) > 0 {
+		validationErr := rule.firstError()
+
+		switch validationErr.Code {
+		case "issue_not_found":
+			return fmt.Sprintf("Issue %s does not exist in %s", validationErr.Context["reference"], validationErr.Context["tracker"])
+		case "issue_status_not_allowed":
+			return fmt.Sprintf("Issue %s has status '%s', allowed statuses are: %s",
+				validationErr.Context["reference"], validationErr.Context["status"],
+				strings.ReplaceAll(validationErr.Context["statuses"], ",", ", "))
+		case "issue_status_forbidden":
+			return fmt.Sprintf("Issue %s has status '%s', forbidden statuses are: %s",
+				validationErr.Context["reference"], validationErr.Context["status"],
+				strings.ReplaceAll(validationErr.Context["statuses"], ",", ", "))
+		case "lookup_failed", "lookup_error":
+			return fmt.Sprintf("Could not verify issue %s: %s", validationErr.Context["reference"], validationErr.Context["error"])
+		default:
+			return validationErr.Error()
+		}
+	}
+
+	if len(rule.checked) == 0 {
+		return "No issue references to verify"
+	}
+
+	return "Verified issues: " + strings.Join(rule.checked, ", ")
+}
+
+// addError adds a structured validation error.
+func (rule *IssueStatus) addError(code, message string, context map[string]string) *model.ValidationError {
+	err := model.NewValidationError("IssueStatus", code, message)
+
+	// Add any context values
+	for key, value := range context {
+		_ = err.WithContext(key, value)
+	}
+
+	rule.errors = append(rule.errors, err)
+
+	return err
+}
+
+// firstError returns the first error that fails the rule, or the first warning if there is none.
+func (rule IssueStatus) firstError() *model.ValidationError {
+	for _, err := range rule.errors {
+		if err.Severity == model.SeverityError {
+			return err
+		}
+	}
+
+	return rule.errors[0]
+}
+
+// Errors returns validation errors.
+func (rule IssueStatus) Errors() []*model.ValidationError {
+	return rule.errors
+}
+
+// Help returns a description of how to fix the rule violation.
+func (rule IssueStatus) Help() string {
+	if len(rule.errors) == 0 {
+		return "No errors to fix"
+	}
+
+	validationErr := rule.firstError()
+
+	switch validationErr.Code {
+	case "issue_not_found":
+		return `Check the issue reference for typos. The issue tracker does not know the issue,
+or the configured token is not allowed to read it.`
+
+	case "issue_status_not_allowed":
+		return fmt.Sprintf(`Reference an issue with one of the allowed statuses: %s.
+Reopen the issue if the work belongs to it, or reference the issue the work belongs to.`,
+			strings.ReplaceAll(validationErr.Context["statuses"], ",", ", "))
+
+	case "issue_status_forbidden":
+		return fmt.Sprintf(`Reference an issue that is not in one of the statuses: %s.
+Reopen the issue if the work belongs to it, or reference the issue the work belongs to.`,
+			strings.ReplaceAll(validationErr.Context["statuses"], ",", ", "))
+
+	case "lookup_failed":
+		return `The issue tracker could not be reached, so the references were not verified.
+This is a warning only. Check the network connection, the tracker URL and the token
+in 'issue-reference.trackers.<name>.api'.`
+
+	case "lookup_error":
+		return `The issue tracker could not look up the reference. Check the token and its
+permissions, and the settings in 'issue-reference.trackers.<name>.api'. A reference
+without a project, e.g. GitHub "#12", needs the 'project' setting.`
+	}
+
+	return validationErr.Message
+}
+
+// ValidateIssueStatus looks up the referenced issues and checks their status.
+//
+// Parameters:
+//   - refs: The references to verify, e.g. IssueReference.References()
+//   - opts: Options with the lookup function and the allowed statuses
+//
+// Returns:
+//   - An IssueStatus instance with validation results
+func ValidateIssueStatus(refs []IssueRef, opts ...IssueStatusOption) *IssueStatus {
+	var config IssueStatusConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	rule := &IssueStatus{}
+
+	if config.Lookup == nil {
+		return rule
+	}
+
+	seen := make(map[IssueRef]bool)
+
+	for _, ref := range refs {
+		// A reference repeated in the subject and a trailer is looked up once
+		key := IssueRef{Tracker: ref.Tracker, Project: ref.Project, ID: ref.ID}
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		rule.checked = append(rule.checked, ref.Text)
+
+		state, err := config.Lookup(ref)
+		if err != nil {
+			context := map[string]string{
+				"reference": ref.Text,
+				"tracker":   ref.Tracker,
+				"error":     err.Error(),
+			}
+
+			if config.Unreachable != nil && config.Unreachable(err) {
+				rule.addError(
+					"lookup_failed",
+					fmt.Sprintf("could not verify issue %s: %s", ref.Text, err),
+					context,
+				).WithSeverity(model.SeverityWarning)
+			} else {
+				rule.addError(
+					"lookup_error",
+					fmt.Sprintf("could not look up issue %s: %s", ref.Text, err),
+					context,
+				)
+			}
+
+			continue
+		}
+
+		if !state.Exists {
+			rule.addError(
+				"issue_not_found",
+				fmt.Sprintf("issue %s does not exist", ref.Text),
+				map[string]string{
+					"reference": ref.Text,
+					"tracker":   ref.Tracker,
+				},
+			)
+
+			continue
+		}
+
+		statuses := config.Statuses[ref.Tracker]
+		if len(statuses) > 0 && !slices.ContainsFunc(statuses, func(status string) bool {
+			return strings.EqualFold(status, state.Status)
+		}) {
+			rule.addError(
+				"issue_status_not_allowed",
+				fmt.Sprintf("issue %s has status %s", ref.Text, state.Status),
+				map[string]string{
+					"reference": ref.Text,
+					"tracker":   ref.Tracker,
+					"status":    state.Status,
+					"statuses":  strings.Join(statuses, ","),
+				},
+			)
+
+			continue
+		}
+
+		forbidden := config.ForbiddenStatuses[ref.Tracker]
+		if slices.ContainsFunc(forbidden, func(status string) bool {
+			return strings.EqualFold(status, state.Status)
+		}) {
+			rule.addError(
+				"issue_status_forbidden",
+				fmt.Sprintf("issue %s has status %s", ref.Text, state.Status),
+				map[string]string{
+					"reference": ref.Text,
+					"tracker":   ref.Tracker,
+					"status":    state.Status,
+					"statuses":  strings.Join(forbidden, ","),
+				},
+			)
+		}
+	}
+
+	return rule
+}
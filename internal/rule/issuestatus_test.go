@@ -0,0 +1,162 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/model"
+	"github.com/itiquette/gommitlint/internal/rule"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateIssueStatus(t *testing.T) {
+	issues := map[string]rule.IssueState{
+		"PROJ-1": {Exists: true, Status: "In Progress"},
+		"PROJ-2": {Exists: true, Status: "Closed"},
+		"#12":    {Exists: true, Status: "open"},
+	}
+
+	errUnreachable := errors.New("dial tcp: connection refused")
+
+	lookup := func(ref rule.IssueRef) (rule.IssueState, error) {
+		switch ref.Tracker {
+		case "offline":
+			return rule.IssueState{}, errUnreachable
+		case "unauthorized":
+			return rule.IssueState{}, errors.New("issue tracker answered 401 Unauthorized")
+		}
+
+		return issues[ref.Text], nil
+	}
+
+	openJira := rule.WithAllowedStatuses("jira", []string{"open", "in progress"})
+
+	tests := []struct {
+		name       string
+		refs       []rule.IssueRef
+		opts       []rule.IssueStatusOption
+		noLookup   bool
+		wantCodes  []string
+		wantFailed bool
+		wantResult string
+	}{
+		{
+			name:       "existing issues",
+			refs:       []rule.IssueRef{{Tracker: "jira", Text: "PROJ-1"}, {Tracker: "github", Text: "#12"}},
+			opts:       []rule.IssueStatusOption{openJira},
+			wantResult: "Referenced issues exist",
+		},
+		{
+			name:       "no lookup",
+			refs:       []rule.IssueRef{{Tracker: "jira", Text: "PROJ-99999"}},
+			noLookup:   true,
+			wantResult: "Referenced issues exist",
+		},
+		{
+			name:       "missing issue",
+			refs:       []rule.IssueRef{{Tracker: "jira", Text: "PROJ-99999"}},
+			wantCodes:  []string{"issue_not_found"},
+			wantFailed: true,
+			wantResult: "Referenced issue not found or not in an allowed status",
+		},
+		{
+			name:       "closed issue",
+			refs:       []rule.IssueRef{{Tracker: "jira", Text: "PROJ-2"}},
+			opts:       []rule.IssueStatusOption{openJira},
+			wantCodes:  []string{"issue_status_not_allowed"},
+			wantFailed: true,
+			wantResult: "Referenced issue not found or not in an allowed status",
+		},
+		{
+			name:       "forbidden status",
+			refs:       []rule.IssueRef{{Tracker: "jira", Text: "PROJ-2"}},
+			opts:       []rule.IssueStatusOption{rule.WithForbiddenStatuses("jira", []string{"closed", "done"})},
+			wantCodes:  []string{"issue_status_forbidden"},
+			wantFailed: true,
+			wantResult: "Referenced issue not found or not in an allowed status",
+		},
+		{
+			name:       "status not forbidden",
+			refs:       []rule.IssueRef{{Tracker: "jira", Text: "PROJ-1"}},
+			opts:       []rule.IssueStatusOption{rule.WithForbiddenStatuses("jira", []string{"closed", "done"})},
+			wantResult: "Referenced issues exist",
+		},
+		{
+			name:       "statuses of another tracker",
+			refs:       []rule.IssueRef{{Tracker: "github", Text: "#12"}},
+			opts:       []rule.IssueStatusOption{openJira},
+			wantResult: "Referenced issues exist",
+		},
+		{
+			name:       "tracker unreachable",
+			refs:       []rule.IssueRef{{Tracker: "offline", Text: "#7"}},
+			wantCodes:  []string{"lookup_failed"},
+			wantResult: "Referenced issues not verified",
+		},
+		{
+			name:       "tracker rejects the lookup",
+			refs:       []rule.IssueRef{{Tracker: "unauthorized", Text: "#7"}},
+			wantCodes:  []string{"lookup_error"},
+			wantFailed: true,
+			wantResult: "Referenced issue not found or not in an allowed status",
+		},
+		{
+			name:       "failure besides a warning",
+			refs:       []rule.IssueRef{{Tracker: "offline", Text: "#7"}, {Tracker: "jira", Text: "PROJ-99999"}},
+			wantCodes:  []string{"lookup_failed", "issue_not_found"},
+			wantFailed: true,
+			wantResult: "Referenced issue not found or not in an allowed status",
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			opts := tabletest.opts
+			if !tabletest.noLookup {
+				opts = append(opts, rule.WithIssueLookup(lookup), rule.WithUnreachableCheck(func(err error) bool {
+					return errors.Is(err, errUnreachable)
+				}))
+			}
+
+			result := rule.ValidateIssueStatus(tabletest.refs, opts...)
+
+			codes := make([]string, 0, len(result.Errors()))
+			for _, err := range result.Errors() {
+				codes = append(codes, err.Code)
+			}
+
+			if len(tabletest.wantCodes) == 0 {
+				require.Empty(t, codes)
+			} else {
+				require.Equal(t, tabletest.wantCodes, codes)
+			}
+
+			require.Equal(t, tabletest.wantFailed, model.Failed(result))
+			require.Equal(t, tabletest.wantResult, result.Result())
+			require.NotEmpty(t, result.VerboseResult())
+			require.NotEmpty(t, result.Help())
+		})
+	}
+}
+
+func TestIssueStatusLooksUpOnce(t *testing.T) {
+	lookups := 0
+	lookup := func(rule.IssueRef) (rule.IssueState, error) {
+		lookups++
+
+		return rule.IssueState{Exists: true, Status: "open"}, nil
+	}
+
+	result := rule.ValidateIssueStatus([]rule.IssueRef{
+		{Tracker: "github", Text: "#12", ID: "12", Placement: rule.PlacementSubjectEnd},
+		{Tracker: "github", Text: "#12", ID: "12", Placement: rule.PlacementTrailer, Closing: true},
+	}, rule.WithIssueLookup(lookup))
+
+	require.Empty(t, result.Errors())
+	require.Equal(t, 1, lookups)
+	require.Equal(t, "Verified issues: #12", result.VerboseResult())
+}
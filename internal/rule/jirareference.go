@@ -13,10 +13,15 @@ import (
 	"github.com/itiquette/gommitlint/internal/model"
 )
 
-// Common regex patterns compiled once at package level.
+// jiraRefsPattern matches the value of a "Refs:" line, a comma-separated list of keys.
+// It is shared by refsLineRegex and JiraRefsTrailer.
+const jiraRefsPattern = jiraKeyPattern + `(?:\s*,\s*` + jiraKeyPattern + `)*`
+
+// Common regex patterns compiled once at package level. Keys are matched with the
+// pattern of the jira issue tracker profile.
 var (
-	jiraKeyRegex  = regexp.MustCompile(`([A-Z]+-\d+)`)
-	refsLineRegex = regexp.MustCompile(`^Refs:\s*([A-Z]+-\d+(?:\s*,\s*[A-Z]+-\d+)*)$`)
+	jiraKeyRegex  = regexp.MustCompile(jiraKeyPattern)
+	refsLineRegex = regexp.MustCompile(`^Refs:\s*(` + jiraRefsPattern + `)$`)
 )
 
 // JiraReference enforces proper Jira issue references in commit messages.
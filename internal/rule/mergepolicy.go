@@ -0,0 +1,284 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/model"
+)
+
+// templatePlaceholderRegex matches a placeholder of a merge subject template, e.g. "<branch>".
+var templatePlaceholderRegex = regexp.MustCompile(`<[\w-]+>`)
+
+// MergePolicyConfig provides configuration for the MergePolicy rule.
+type MergePolicyConfig struct {
+	// Forbid rejects all merge commits, enforcing a rebase workflow
+	Forbid bool
+
+	// BaseBranchOnly only allows merging commits of BaseBranch
+	BaseBranchOnly bool
+
+	// BaseBranch is the branch merged commits must be part of, empty if it is unknown
+	BaseBranch string
+
+	// IsAncestor reports whether a commit is part of a revision
+	IsAncestor func(hash, revision string) bool
+
+	// SubjectTemplate is the template merge subjects must match, e.g. "Merge branch '<source>' into <target>"
+	SubjectTemplate string
+}
+
+// MergePolicyOption configures a MergePolicyConfig.
+type MergePolicyOption func(*MergePolicyConfig)
+
+// WithMergesForbidden sets whether merge commits are rejected.
+func WithMergesForbidden(forbid bool) MergePolicyOption {
+	return func(c *MergePolicyConfig) {
+		c.Forbid = forbid
+	}
+}
+
+// WithMergeBaseBranch only allows merging commits that isAncestor reports as part of the
+// base branch. An empty base branch cannot be checked and is reported as an error.
+func WithMergeBaseBranch(baseBranch string, isAncestor func(hash, revision string) bool) MergePolicyOption {
+	return func(c *MergePolicyConfig) {
+		c.BaseBranchOnly = true
+		c.BaseBranch = baseBranch
+		c.IsAncestor = isAncestor
+	}
+}
+
+// WithMergeSubjectTemplate sets the template merge subjects must match.
+func WithMergeSubjectTemplate(template string) MergePolicyOption {
+	return func(c *MergePolicyConfig) {
+		c.SubjectTemplate = template
+	}
+}
+
+// MergePolicy enforces a policy on merge commits.
+//
+// Merge commits are commits with more than one parent. Depending on the configuration
+// the rule:
+//
+//   - rejects all merge commits, enforcing a linear history and a rebase workflow
+//   - only allows merging the base branch, e.g. to update a feature branch, so that
+//     feature branches are not merged into each other
+//   - requires merge subjects to match a template such as "Merge branch '<source>' into <target>",
+//     where each "<placeholder>" matches any text
+//
+// Commits that are not merge commits pass.
+//
+// Examples:
+//
+//   - With merges forbidden, any merge commit would fail
+//   - With the template "Merge branch '<source>' into <target>":
+//     "Merge branch 'main' into feat/login" would pass
+//     "Merge remote-tracking branch 'origin/main'" would fail
+type MergePolicy struct {
+	isMerge bool
+	subject string
+	errors  []*model.ValidationError
+}
+
+// Name returns the rule name.
+func (rule MergePolicy) Name() string {
+	return "MergePolicy"
+}
+
+// Result returns a concise validation result.
+func (rule MergePolicy) Result() string {
+	if len(rule.errors) > 0 {
+		return "Merge policy violated"
+	}
+
+	if !rule.isMerge {
+		return "Not a merge commit"
+	}
+
+	return "Merge commit allowed"
+}
+
+// VerboseResult returns a more detailed explanation for verbose mode.
+func (rule MergePolicy) VerboseResult() string {
+	if len(rule.errors) > 0 {
+		switch rule.errors[0].Code {
+		case "merge_commit_forbidden":
+			return fmt.Sprintf("Merge commit '%s' is not allowed: the history must be linear", rule.subject)
+		case "merge_source_not_allowed":
+			return fmt.Sprintf("Merge commit '%s' merges commit %s, which is not part of the base branch %s",
+				rule.subject, rule.errors[0].Context["commit"], rule.errors[0].Context["base_branch"])
+		case "base_branch_unknown":
+			return fmt.Sprintf("Merge commit '%s' cannot be checked: no base branch is known", rule.subject)
+		case "invalid_merge_subject":
+			return fmt.Sprintf("Merge subject '%s' does not match the template '%s'",
+				rule.subject, rule.errors[0].Context["template"])
+		default:
+			return rule.errors[0].Error()
+		}
+	}
+
+	if !rule.isMerge {
+		return "Commit has a single parent"
+	}
+
+	return fmt.Sprintf("Merge commit '%s' follows the merge policy", rule.subject)
+}
+
+// addError adds a structured validation error.
+func (rule *MergePolicy) addError(code, message string, context map[string]string) {
+	err := model.NewValidationError("MergePolicy", code, message)
+
+	// Add any context values
+	for key, value := range context {
+		_ = err.WithContext(key, value)
+	}
+
+	rule.errors = append(rule.errors, err)
+}
+
+// Errors returns validation errors.
+func (rule MergePolicy) Errors() []*model.ValidationError {
+	return rule.errors
+}
+
+// Help returns a description of how to fix the rule violation.
+func (rule MergePolicy) Help() string {
+	if len(rule.errors) == 0 {
+		return "No errors to fix"
+	}
+
+	switch rule.errors[0].Code {
+	case "merge_commit_forbidden":
+		return `This repository requires a linear history. Rebase the branch instead of merging:
+
+  git rebase <base-branch>
+
+To update a branch that already contains merge commits, rebase it onto the base
+branch, which drops the merge commits and replays the other commits.`
+
+	case "merge_source_not_allowed":
+		return `Only the base branch may be merged into this branch, e.g. to bring it up to date.
+Merging other branches mixes unrelated changes. Rebase the branch onto the base
+branch, or merge the other branch into the base branch first.`
+
+	case "base_branch_unknown":
+		return `The merge policy only allows merging the base branch, but no base branch is known.
+Pass the base branch with '--base-branch', or create a 'main' or 'master' branch.`
+
+	case "invalid_merge_subject":
+		return fmt.Sprintf(`Use a merge subject that matches the template:
+
+  %s
+
+Each <placeholder> stands for any text. Reword the merge commit with
+'git commit --amend' or 'git rebase -i --rebase-merges'.`, rule.errors[0].Context["template"])
+	}
+
+	return rule.errors[0].Message
+}
+
+// ValidateMergePolicy checks a commit against the merge policy.
+//
+// Parameters:
+//   - subject: The commit subject line
+//   - parentHashes: The hashes of the commit's parents; a merge commit has more than one
+//   - opts: Options enabling the individual checks
+//
+// Returns:
+//   - A MergePolicy instance with validation results
+func ValidateMergePolicy(subject string, parentHashes []string, opts ...MergePolicyOption) *MergePolicy {
+	var config MergePolicyConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	rule := &MergePolicy{subject: subject, isMerge: len(parentHashes) > 1}
+	if !rule.isMerge {
+		return rule
+	}
+
+	if config.Forbid {
+		rule.addError(
+			"merge_commit_forbidden",
+			"merge commits are not allowed",
+			map[string]string{
+				"subject": subject,
+			},
+		)
+
+		return rule
+	}
+
+	switch {
+	case !config.BaseBranchOnly:
+	case config.BaseBranch == "":
+		rule.addError(
+			"base_branch_unknown",
+			"cannot check the merged commits without a base branch",
+			map[string]string{
+				"subject": subject,
+			},
+		)
+	default:
+		for _, hash := range parentHashes[1:] {
+			if !config.IsAncestor(hash, config.BaseBranch) {
+				rule.addError(
+					"merge_source_not_allowed",
+					fmt.Sprintf("merged commit %s is not part of the base branch %s", shortHash(hash), config.BaseBranch),
+					map[string]string{
+						"commit":      shortHash(hash),
+						"base_branch": config.BaseBranch,
+					},
+				)
+			}
+		}
+	}
+
+	if config.SubjectTemplate != "" && !mergeTemplateRegex(config.SubjectTemplate).MatchString(subject) {
+		rule.addError(
+			"invalid_merge_subject",
+			fmt.Sprintf("merge subject does not match template %q", config.SubjectTemplate),
+			map[string]string{
+				"subject":  subject,
+				"template": config.SubjectTemplate,
+			},
+		)
+	}
+
+	return rule
+}
+
+// mergeTemplateRegex converts a merge subject template into a regular expression.
+// Placeholders match any non-empty text, everything else matches literally.
+func mergeTemplateRegex(template string) *regexp.Regexp {
+	var pattern strings.Builder
+
+	pattern.WriteString("^")
+
+	last := 0
+	for _, loc := range templatePlaceholderRegex.FindAllStringIndex(template, -1) {
+		pattern.WriteString(regexp.QuoteMeta(template[last:loc[0]]))
+		pattern.WriteString("(.+)")
+
+		last = loc[1]
+	}
+
+	pattern.WriteString(regexp.QuoteMeta(template[last:]))
+	pattern.WriteString("$")
+
+	return regexp.MustCompile(pattern.String())
+}
+
+// shortHash abbreviates a commit hash for messages.
+func shortHash(hash string) string {
+	if len(hash) > 7 {
+		return hash[:7]
+	}
+
+	return hash
+}
@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/rule"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateMergePolicy(t *testing.T) {
+	const (
+		baseCommit    = "1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b"
+		featureCommit = "9f8e7d6c5b4a39281706f5e4d3c2b1a098765432"
+		parentCommit  = "0a0b0c0d0e0f0a0b0c0d0e0f0a0b0c0d0e0f0a0b"
+	)
+
+	inBase := rule.WithMergeBaseBranch("main", func(hash, revision string) bool {
+		return hash == baseCommit && revision == "main"
+	})
+	template := rule.WithMergeSubjectTemplate("Merge branch '<source>' into <target>")
+
+	tests := []struct {
+		name      string
+		subject   string
+		parents   []string
+		opts      []rule.MergePolicyOption
+		wantCodes []string
+	}{
+		{
+			name:    "not a merge commit",
+			subject: "feat: add login",
+			parents: []string{parentCommit},
+			opts:    []rule.MergePolicyOption{rule.WithMergesForbidden(true), inBase, template},
+		},
+		{
+			name:    "root commit",
+			subject: "Initial commit",
+			opts:    []rule.MergePolicyOption{rule.WithMergesForbidden(true)},
+		},
+		{
+			name:    "merge without policy",
+			subject: "Merge anything",
+			parents: []string{parentCommit, featureCommit},
+		},
+		{
+			name:      "merges forbidden",
+			subject:   "Merge branch 'main' into feat/login",
+			parents:   []string{parentCommit, baseCommit},
+			opts:      []rule.MergePolicyOption{rule.WithMergesForbidden(true), inBase, template},
+			wantCodes: []string{"merge_commit_forbidden"},
+		},
+		{
+			name:    "merge from base branch",
+			subject: "Merge branch 'main' into feat/login",
+			parents: []string{parentCommit, baseCommit},
+			opts:    []rule.MergePolicyOption{inBase},
+		},
+		{
+			name:      "merge from feature branch",
+			subject:   "Merge branch 'feat/other' into feat/login",
+			parents:   []string{parentCommit, featureCommit},
+			opts:      []rule.MergePolicyOption{inBase},
+			wantCodes: []string{"merge_source_not_allowed"},
+		},
+		{
+			name:      "octopus merge with a feature branch",
+			subject:   "Merge branches 'main' and 'feat/other' into feat/login",
+			parents:   []string{parentCommit, baseCommit, featureCommit},
+			opts:      []rule.MergePolicyOption{inBase},
+			wantCodes: []string{"merge_source_not_allowed"},
+		},
+		{
+			name:      "base branch unknown",
+			subject:   "Merge branch 'main' into feat/login",
+			parents:   []string{parentCommit, baseCommit},
+			opts:      []rule.MergePolicyOption{rule.WithMergeBaseBranch("", nil)},
+			wantCodes: []string{"base_branch_unknown"},
+		},
+		{
+			name:    "subject matches template",
+			subject: "Merge branch 'main' into feat/login",
+			parents: []string{parentCommit, baseCommit},
+			opts:    []rule.MergePolicyOption{template},
+		},
+		{
+			name:      "subject does not match template",
+			subject:   "Merge remote-tracking branch 'origin/main'",
+			parents:   []string{parentCommit, baseCommit},
+			opts:      []rule.MergePolicyOption{template},
+			wantCodes: []string{"invalid_merge_subject"},
+		},
+		{
+			name:    "template special characters match literally",
+			subject: "Merge (main) into feat.login",
+			parents: []string{parentCommit, baseCommit},
+			opts:    []rule.MergePolicyOption{rule.WithMergeSubjectTemplate("Merge (<source>) into <target>")},
+		},
+		{
+			name:      "source and subject violations",
+			subject:   "Merge stuff",
+			parents:   []string{parentCommit, featureCommit},
+			opts:      []rule.MergePolicyOption{inBase, template},
+			wantCodes: []string{"merge_source_not_allowed", "invalid_merge_subject"},
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			result := rule.ValidateMergePolicy(tabletest.subject, tabletest.parents, tabletest.opts...)
+
+			codes := make([]string, 0, len(result.Errors()))
+			for _, err := range result.Errors() {
+				codes = append(codes, err.Code)
+			}
+
+			if len(tabletest.wantCodes) == 0 {
+				require.Empty(t, codes)
+				require.Equal(t, "No errors to fix", result.Help())
+
+				return
+			}
+
+			require.Equal(t, tabletest.wantCodes, codes)
+			require.Equal(t, "Merge policy violated", result.Result())
+		})
+	}
+}
+
+func TestMergePolicyResult(t *testing.T) {
+	require.Equal(t, "Not a merge commit", rule.ValidateMergePolicy("feat: add login", []string{"a"}).Result())
+	require.Equal(t, "Merge commit allowed", rule.ValidateMergePolicy("Merge branch 'main'", []string{"a", "b"}).Result())
+
+	result := rule.ValidateMergePolicy("Merge stuff", []string{"a", "9f8e7d6c5b4a39281706f5e4d3c2b1a098765432"},
+		rule.WithMergeBaseBranch("main", func(string, string) bool { return false }))
+	require.Equal(t, "9f8e7d6", result.Errors()[0].Context["commit"])
+	require.Equal(t, "main", result.Errors()[0].Context["base_branch"])
+}
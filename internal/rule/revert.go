@@ -0,0 +1,229 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/model"
+)
+
+// gitRevertSubjectRegex matches the subject "git revert" writes: Revert "<original subject>".
+var gitRevertSubjectRegex = regexp.MustCompile(`^Revert "(.+)"$`)
+
+// conventionalRevertSubjectRegex matches a conventional revert subject such as "revert(api): add x".
+var conventionalRevertSubjectRegex = regexp.MustCompile(`^revert(?:\([\w,/-]+\))?!?: (.+)$`)
+
+// revertReferenceRegex matches the line "git revert" adds to the body. Reverting a merge
+// appends ", reversing changes made to <sha>." instead of the period.
+var revertReferenceRegex = regexp.MustCompile(`^This reverts commit ([0-9a-fA-F]{7,40})[.,]`)
+
+// IsRevertSubject reports whether the subject is the subject of a revert commit, either in
+// the format "git revert" writes or as a conventional "revert:" commit.
+func IsRevertSubject(subject string) bool {
+	return strings.HasPrefix(subject, `Revert "`) || conventionalRevertSubjectRegex.MatchString(subject)
+}
+
+// RevertConfig provides configuration for the Revert rule.
+type RevertConfig struct {
+	// CommitExists, if set, reports whether the reverted commit exists in the repository
+	CommitExists func(hash string) bool
+}
+
+// RevertOption configures a RevertConfig.
+type RevertOption func(*RevertConfig)
+
+// WithRevertedCommitCheck verifies that the reverted commit exists.
+func WithRevertedCommitCheck(commitExists func(hash string) bool) RevertOption {
+	return func(c *RevertConfig) {
+		c.CommitExists = commitExists
+	}
+}
+
+// Revert validates the format of revert commits.
+//
+// Two subject formats are recognised: the one "git revert" writes, Revert "<subject>",
+// and the conventional "revert: <subject>". A revert commit must name the reverted commit
+// in its body with the line "git revert" adds:
+//
+//	This reverts commit 1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b.
+//
+// Optionally the reverted commit must exist in the repository, which catches references
+// to commits that were rebased away or never merged. Commits that are not reverts pass.
+//
+// Examples:
+//
+//   - Revert "feat: add login" with "This reverts commit 1a2b3c4." in the body would pass
+//   - "revert: add login" without a "This reverts commit" line would fail
+//   - Revert "feat: add login (missing closing quote) would fail
+type Revert struct {
+	isRevert        bool
+	revertedSubject string
+	revertedCommits []string
+	errors          []*model.ValidationError
+}
+
+// Name returns the rule name.
+func (rule Revert) Name() string {
+	return "Revert"
+}
+
+// Result returns a concise validation result.
+func (rule Revert) Result() string {
+	if len(rule.errors) > 0 {
+		return "Invalid revert commit"
+	}
+
+	if !rule.isRevert {
+		return "Not a revert commit"
+	}
+
+	return "Valid revert commit"
+}
+
+// VerboseResult returns a more detailed explanation for verbose mode.
+func (rule Revert) VerboseResult() string {
+	if len(rule.errors) > 0 {
+		switch rule.errors[0].Code {
+		case "invalid_revert_subject":
+			return fmt.Sprintf("Revert subject '%s' does not quote the reverted subject: use Revert \"<subject>\"",
+				rule.errors[0].Context["subject"])
+		case "missing_revert_reference":
+			return "Revert commit does not name the reverted commit with 'This reverts commit <sha>.'"
+		case "unknown_reverted_commit":
+			return fmt.Sprintf("Reverted commit %s does not exist in the repository", rule.errors[0].Context["commit"])
+		default:
+			return rule.errors[0].Error()
+		}
+	}
+
+	if !rule.isRevert {
+		return "Commit is not a revert commit"
+	}
+
+	return fmt.Sprintf("Reverts '%s' (commit %s)", rule.revertedSubject, strings.Join(rule.revertedCommits, ", "))
+}
+
+// addError adds a structured validation error.
+func (rule *Revert) addError(code, message string, context map[string]string) {
+	err := model.NewValidationError("Revert", code, message)
+
+	// Add any context values
+	for key, value := range context {
+		_ = err.WithContext(key, value)
+	}
+
+	rule.errors = append(rule.errors, err)
+}
+
+// Errors returns validation errors.
+func (rule Revert) Errors() []*model.ValidationError {
+	return rule.errors
+}
+
+// Help returns a description of how to fix the rule violation.
+func (rule Revert) Help() string {
+	if len(rule.errors) == 0 {
+		return "No errors to fix"
+	}
+
+	switch rule.errors[0].Code {
+	case "invalid_revert_subject":
+		return `Use the subject 'git revert' generates, with the reverted subject in double quotes:
+
+Revert "feat: add login"
+
+Or use the conventional format: revert: add login`
+
+	case "missing_revert_reference":
+		return `Name the reverted commit in the commit body, as 'git revert' does:
+
+This reverts commit 1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b.
+
+Creating the commit with 'git revert <sha>' adds this line automatically.`
+
+	case "unknown_reverted_commit":
+		return fmt.Sprintf(`Commit %s does not exist in this repository.
+Check the hash in the 'This reverts commit' line. If the reverted commit was
+rebased, use its new hash, which 'git log' shows.`, rule.errors[0].Context["commit"])
+	}
+
+	return rule.errors[0].Message
+}
+
+// ValidateRevert checks the subject and reverted commit reference of a revert commit.
+//
+// Parameters:
+//   - subject: The commit subject line
+//   - body: The commit body
+//   - opts: Options enabling the existence check of the reverted commit
+//
+// Returns:
+//   - A Revert instance with validation results
+func ValidateRevert(subject, body string, opts ...RevertOption) *Revert {
+	var config RevertConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	rule := &Revert{isRevert: IsRevertSubject(subject)}
+	if !rule.isRevert {
+		return rule
+	}
+
+	if match := gitRevertSubjectRegex.FindStringSubmatch(subject); match != nil {
+		rule.revertedSubject = match[1]
+	} else if match := conventionalRevertSubjectRegex.FindStringSubmatch(subject); match != nil {
+		rule.revertedSubject = match[1]
+	} else {
+		rule.addError(
+			"invalid_revert_subject",
+			"revert subject must quote the reverted subject: Revert \"<subject>\"",
+			map[string]string{
+				"subject": subject,
+			},
+		)
+
+		return rule
+	}
+
+	for _, line := range strings.Split(body, "\n") {
+		if match := revertReferenceRegex.FindStringSubmatch(strings.TrimSpace(line)); match != nil {
+			rule.revertedCommits = append(rule.revertedCommits, match[1])
+		}
+	}
+
+	if len(rule.revertedCommits) == 0 {
+		rule.addError(
+			"missing_revert_reference",
+			"revert commit must contain 'This reverts commit <sha>.'",
+			map[string]string{
+				"subject": subject,
+			},
+		)
+
+		return rule
+	}
+
+	if config.CommitExists == nil {
+		return rule
+	}
+
+	for _, hash := range rule.revertedCommits {
+		if !config.CommitExists(hash) {
+			rule.addError(
+				"unknown_reverted_commit",
+				fmt.Sprintf("reverted commit %s not found in repository", hash),
+				map[string]string{
+					"commit": hash,
+				},
+			)
+		}
+	}
+
+	return rule
+}
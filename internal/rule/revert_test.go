@@ -0,0 +1,100 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/rule"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateRevert(t *testing.T) {
+	const knownCommit = "1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b"
+
+	commitExists := func(hash string) bool {
+		return strings.HasPrefix(knownCommit, strings.ToLower(hash))
+	}
+
+	tests := []struct {
+		name       string
+		subject    string
+		body       string
+		opts       []rule.RevertOption
+		wantRevert bool
+		wantCode   string
+	}{
+		{
+			name:    "not a revert",
+			subject: "feat: add login",
+		},
+		{
+			name:    "prose starting with revert is not a git revert",
+			subject: "Revert the timeout change",
+		},
+		{
+			name:       "git revert format",
+			subject:    `Revert "feat: add login"`,
+			body:       "This reverts commit " + knownCommit + ".",
+			wantRevert: true,
+		},
+		{
+			name:       "conventional revert with short hash",
+			subject:    "revert(auth): add login",
+			body:       "Login broke SSO.\n\nThis reverts commit 1a2b3c4.",
+			opts:       []rule.RevertOption{rule.WithRevertedCommitCheck(commitExists)},
+			wantRevert: true,
+		},
+		{
+			name:       "reverted merge",
+			subject:    `Revert "Merge branch 'login'"`,
+			body:       "This reverts commit " + knownCommit + ", reversing\nchanges made to 0123456.",
+			opts:       []rule.RevertOption{rule.WithRevertedCommitCheck(commitExists)},
+			wantRevert: true,
+		},
+		{
+			name:       "missing reference",
+			subject:    `Revert "feat: add login"`,
+			body:       "It broke SSO.",
+			wantRevert: true,
+			wantCode:   "missing_revert_reference",
+		},
+		{
+			name:       "unclosed quote",
+			subject:    `Revert "feat: add login`,
+			body:       "This reverts commit " + knownCommit + ".",
+			wantRevert: true,
+			wantCode:   "invalid_revert_subject",
+		},
+		{
+			name:       "unknown reverted commit",
+			subject:    `Revert "feat: add login"`,
+			body:       "This reverts commit 0123456789abcdef.",
+			opts:       []rule.RevertOption{rule.WithRevertedCommitCheck(commitExists)},
+			wantRevert: true,
+			wantCode:   "unknown_reverted_commit",
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			require.Equal(t, tabletest.wantRevert, rule.IsRevertSubject(tabletest.subject))
+
+			result := rule.ValidateRevert(tabletest.subject, tabletest.body, tabletest.opts...)
+
+			if tabletest.wantCode == "" {
+				require.Empty(t, result.Errors())
+				require.Equal(t, "No errors to fix", result.Help())
+
+				return
+			}
+
+			require.Len(t, result.Errors(), 1)
+			require.Equal(t, tabletest.wantCode, result.Errors()[0].Code)
+			require.Equal(t, "Invalid revert commit", result.Result())
+		})
+	}
+}
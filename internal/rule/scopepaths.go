@@ -0,0 +1,281 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/git"
+	"github.com/itiquette/gommitlint/internal/model"
+)
+
+// maxListedPaths limits the number of paths listed in results and errors.
+const maxListedPaths = 5
+
+// ScopePathsConfig provides configuration for the ScopePaths rule.
+type ScopePathsConfig struct {
+	// Scopes maps each scope to the path patterns it covers
+	Scopes map[string][]string
+
+	// IgnorePatterns lists path patterns any scope may change
+	IgnorePatterns []string
+}
+
+// ScopePathsOption configures a ScopePathsConfig.
+type ScopePathsOption func(*ScopePathsConfig)
+
+// WithScopePaths sets the path patterns covered by each scope.
+func WithScopePaths(scopes map[string][]string) ScopePathsOption {
+	return func(c *ScopePathsConfig) {
+		c.Scopes = scopes
+	}
+}
+
+// WithIgnoredPaths sets the path patterns any scope may change, e.g. lock files.
+func WithIgnoredPaths(patterns []string) ScopePathsOption {
+	return func(c *ScopePathsConfig) {
+		c.IgnorePatterns = append(c.IgnorePatterns, patterns...)
+	}
+}
+
+// ScopePaths checks that the scope of a conventional commit covers the files the commit changes.
+//
+// In a monorepo the scope tells readers and release tools which component a commit belongs
+// to, e.g. "feat(billing): add invoices" for a change below services/billing. Each scope is
+// mapped to path patterns, and every file the commit changes compared to its first parent
+// must match a pattern of one of its scopes. Files matching an ignore pattern, such as
+// shared lock files, may be changed by any scope. Scopes without a mapping are left to the
+// ConventionalCommit rule.
+//
+// A commit without a scope that changes files covered by mapped scopes passes with a
+// warning that suggests the scopes covering the files.
+//
+// Patterns use the syntax of "when.paths": "services/billing/" and "services/billing/**"
+// match everything below the directory, "*.proto" matches file names in any directory.
+//
+// Examples:
+//
+//   - With billing: ["services/billing/**"]:
+//     "feat(billing): add invoices" changing services/billing/invoice.go would pass
+//     "feat(billing): add invoices" changing services/web/app.go would fail
+//     "feat: add invoices" changing services/billing/invoice.go would pass with a warning suggesting "billing"
+type ScopePaths struct {
+	scopes    []string
+	paths     []string
+	suggested []string
+	errors    []*model.ValidationError
+}
+
+// Name returns the rule name.
+func (rule ScopePaths) Name() string {
+	return "ScopePaths"
+}
+
+// Result returns a concise validation result.
+func (rule ScopePaths) Result() string {
+	if model.Failed(rule) {
+		return "Scope does not match changed paths"
+	}
+
+	if len(rule.errors) > 0 {
+		return "Scope suggested for changed paths"
+	}
+
+	return "Scope matches changed paths"
+}
+
+// VerboseResult returns a more detailed explanation for verbose mode.
+func (rule ScopePaths) VerboseResult() string {
+	if len(rule.errors) > 0 {
+		switch rule.errors[0].Code {
+		case "scope_path_mismatch":
+			return fmt.Sprintf("Scope '%s' does not cover the changed files %s. Suggested scope: '%s'",
+				rule.errors[0].Context["scope"], rule.errors[0].Context["paths"], rule.errors[0].Context["suggested_scope"])
+		case "missing_scope":
+			return fmt.Sprintf("Commit has no scope but changes files of scope '%s'", rule.errors[0].Context["suggested_scope"])
+		default:
+			return rule.errors[0].Error()
+		}
+	}
+
+	if len(rule.paths) == 0 {
+		return "Commit changes no files covered by a scope"
+	}
+
+	return fmt.Sprintf("Scope '%s' covers the changed files %s", strings.Join(rule.scopes, ","), listPaths(rule.paths))
+}
+
+// addError adds a structured validation error and returns it, so that its severity can be set.
+func (rule *ScopePaths) addError(code, message string, context map[string]string) *model.ValidationError {
+	err := model.NewValidationError("ScopePaths", code, message)
+
+	// Add any context values
+	for key, value := range context {
+		_ = err.WithContext(key, value)
+	}
+
+	rule.errors = append(rule.errors, err)
+
+	return err
+}
+
+// Errors returns validation errors.
+func (rule ScopePaths) Errors() []*model.ValidationError {
+	return rule.errors
+}
+
+// Help returns a description of how to fix the rule violation.
+func (rule ScopePaths) Help() string {
+	if len(rule.errors) == 0 {
+		return "No errors to fix"
+	}
+
+	switch rule.errors[0].Code {
+	case "scope_path_mismatch":
+		return fmt.Sprintf(`The scope must cover every file the commit changes.
+Files outside scope '%s': %s
+
+Use the scope '%s', or split the commit so that each commit only changes
+the files of its scope. The mapping of scopes to paths is configured in
+'conventional-commit.scope-paths.scopes'.`,
+			rule.errors[0].Context["scope"], rule.errors[0].Context["paths"], rule.errors[0].Context["suggested_scope"])
+
+	case "missing_scope":
+		return fmt.Sprintf(`Add the scope of the changed files to the subject:
+
+type(%s): description`, rule.errors[0].Context["suggested_scope"])
+	}
+
+	return rule.errors[0].Message
+}
+
+// ValidateScopePaths checks that the scopes of a conventional commit cover the changed paths.
+//
+// Parameters:
+//   - commit: The parsed conventional commit, nil if the message is not a conventional commit
+//   - changedPaths: The paths the commit changes compared to its first parent
+//   - opts: Options with the mapping of scopes to paths
+//
+// Returns:
+//   - A ScopePaths instance with validation results
+func ValidateScopePaths(commit *model.ConventionalCommit, changedPaths []string, opts ...ScopePathsOption) *ScopePaths {
+	var config ScopePathsConfig
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	rule := &ScopePaths{}
+	if commit == nil || len(config.Scopes) == 0 {
+		return rule
+	}
+
+	rule.scopes = commit.Scopes
+
+	for _, changedPath := range changedPaths {
+		if !matchesAnyPath(config.IgnorePatterns, changedPath) {
+			rule.paths = append(rule.paths, changedPath)
+		}
+	}
+
+	if len(commit.Scopes) == 0 {
+		rule.suggested = scopesCovering(config.Scopes, rule.paths)
+		if len(rule.suggested) > 0 {
+			rule.addError(
+				"missing_scope",
+				"commit without scope changes files of scope "+strings.Join(rule.suggested, ","),
+				map[string]string{
+					"suggested_scope": strings.Join(rule.suggested, ","),
+				},
+			).WithSeverity(model.SeverityWarning)
+		}
+
+		return rule
+	}
+
+	var mapped []string
+
+	for _, scope := range commit.Scopes {
+		if _, ok := config.Scopes[scope]; ok {
+			mapped = append(mapped, scope)
+		}
+	}
+
+	// Scopes without a mapping are left to the allowlist of the ConventionalCommit rule
+	if len(mapped) == 0 {
+		return rule
+	}
+
+	var uncovered []string
+
+	for _, changedPath := range rule.paths {
+		covered := slices.ContainsFunc(mapped, func(scope string) bool {
+			return matchesAnyPath(config.Scopes[scope], changedPath)
+		})
+
+		if !covered {
+			uncovered = append(uncovered, changedPath)
+		}
+	}
+
+	if len(uncovered) == 0 {
+		return rule
+	}
+
+	suggested := append(slices.Clone(mapped), scopesCovering(config.Scopes, uncovered)...)
+	sort.Strings(suggested)
+	rule.suggested = slices.Compact(suggested)
+
+	rule.addError(
+		"scope_path_mismatch",
+		fmt.Sprintf("scope %s does not cover changed files %s", commit.Scope(), listPaths(uncovered)),
+		map[string]string{
+			"scope":           commit.Scope(),
+			"paths":           listPaths(uncovered),
+			"suggested_scope": strings.Join(rule.suggested, ","),
+		},
+	)
+
+	return rule
+}
+
+// scopesCovering returns the sorted scopes whose patterns match at least one of the paths.
+func scopesCovering(scopes map[string][]string, paths []string) []string {
+	var covering []string
+
+	for scope, patterns := range scopes {
+		if slices.ContainsFunc(paths, func(changedPath string) bool {
+			return matchesAnyPath(patterns, changedPath)
+		}) {
+			covering = append(covering, scope)
+		}
+	}
+
+	sort.Strings(covering)
+
+	return covering
+}
+
+// matchesAnyPath reports whether the path matches any of the path patterns.
+func matchesAnyPath(patterns []string, changedPath string) bool {
+	for _, pattern := range patterns {
+		if git.MatchPath(pattern, changedPath) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// listPaths formats paths for messages, listing at most maxListedPaths of them.
+func listPaths(paths []string) string {
+	if len(paths) <= maxListedPaths {
+		return strings.Join(paths, ", ")
+	}
+
+	return fmt.Sprintf("%s and %d more", strings.Join(paths[:maxListedPaths], ", "), len(paths)-maxListedPaths)
+}
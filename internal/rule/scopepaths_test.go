@@ -0,0 +1,126 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/model"
+	"github.com/itiquette/gommitlint/internal/rule"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateScopePaths(t *testing.T) {
+	scopes := rule.WithScopePaths(map[string][]string{
+		"billing": {"services/billing/**"},
+		"web":     {"apps/web/"},
+		"proto":   {"*.proto"},
+	})
+
+	tests := []struct {
+		name          string
+		subject       string
+		paths         []string
+		opts          []rule.ScopePathsOption
+		wantCode      string
+		wantSuggested string
+	}{
+		{
+			name:    "not a conventional commit",
+			subject: "Add invoices",
+			paths:   []string{"apps/web/app.ts"},
+			opts:    []rule.ScopePathsOption{scopes},
+		},
+		{
+			name:    "no mapping configured",
+			subject: "feat(billing): add invoices",
+			paths:   []string{"apps/web/app.ts"},
+		},
+		{
+			name:    "scope covers changed files",
+			subject: "feat(billing): add invoices",
+			paths:   []string{"services/billing/invoice.go", "services/billing/api/v1.go"},
+			opts:    []rule.ScopePathsOption{scopes},
+		},
+		{
+			name:    "several scopes cover changed files",
+			subject: "feat(billing,proto): add invoices",
+			paths:   []string{"services/billing/invoice.go", "api/billing.proto"},
+			opts:    []rule.ScopePathsOption{scopes},
+		},
+		{
+			name:    "unmapped scope is left to the allowlist",
+			subject: "chore(deps): bump modules",
+			paths:   []string{"go.mod", "go.sum"},
+			opts:    []rule.ScopePathsOption{scopes},
+		},
+		{
+			name:          "scope does not cover changed files",
+			subject:       "feat(billing): add invoices",
+			paths:         []string{"services/billing/invoice.go", "apps/web/invoices.ts"},
+			opts:          []rule.ScopePathsOption{scopes},
+			wantCode:      "scope_path_mismatch",
+			wantSuggested: "billing,web",
+		},
+		{
+			name:          "file outside every scope",
+			subject:       "feat(billing): add invoices",
+			paths:         []string{"services/billing/invoice.go", "go.sum"},
+			opts:          []rule.ScopePathsOption{scopes},
+			wantCode:      "scope_path_mismatch",
+			wantSuggested: "billing",
+		},
+		{
+			name:    "ignored file",
+			subject: "feat(billing): add invoices",
+			paths:   []string{"services/billing/invoice.go", "go.sum"},
+			opts:    []rule.ScopePathsOption{scopes, rule.WithIgnoredPaths([]string{"go.sum"})},
+		},
+		{
+			name:          "missing scope is suggested",
+			subject:       "feat: add invoices",
+			paths:         []string{"services/billing/invoice.go"},
+			opts:          []rule.ScopePathsOption{scopes},
+			wantCode:      "missing_scope",
+			wantSuggested: "billing",
+		},
+		{
+			name:    "missing scope without covered files",
+			subject: "docs: update readme",
+			paths:   []string{"README.md"},
+			opts:    []rule.ScopePathsOption{scopes},
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			result := rule.ValidateScopePaths(model.ParseConventionalCommit(tabletest.subject), tabletest.paths, tabletest.opts...)
+
+			if tabletest.wantCode == "" {
+				require.Empty(t, result.Errors())
+				require.Equal(t, "Scope matches changed paths", result.Result())
+				require.Equal(t, "No errors to fix", result.Help())
+
+				return
+			}
+
+			require.Len(t, result.Errors(), 1)
+			require.Equal(t, tabletest.wantCode, result.Errors()[0].Code)
+			require.Equal(t, tabletest.wantSuggested, result.Errors()[0].Context["suggested_scope"])
+			require.Contains(t, result.Help(), tabletest.wantSuggested)
+
+			// A missing scope is only suggested, so that scopes stay optional
+			if tabletest.wantCode == "missing_scope" {
+				require.False(t, model.Failed(result))
+				require.Equal(t, "Scope suggested for changed paths", result.Result())
+
+				return
+			}
+
+			require.True(t, model.Failed(result))
+			require.Equal(t, "Scope does not match changed paths", result.Result())
+		})
+	}
+}
@@ -41,6 +41,29 @@ a0G5Z3xhN014pKDLDI1/hEyemtSZlMkDJwoW9nc1q7AE8lNM1WBmeSZXqoZbgZn3V2Nl
 FxlS+hzWnbOPMrRKuSfJ+H8mF6t1V3qUYtxHNQvHtcCvG0gx4auPSoxp7qVCVQ==
 -----END SSH SIGNATURE-----`
 
+	// Example of a valid X.509 (CMS) signature as written by gpgsm
+	validX509Signature := `-----BEGIN SIGNED MESSAGE-----
+MIIDigYJKoZIhvcNAQcCoIIDezCCA3cCAQExDTALBglghkgBZQMEAgEwCwYJKoZI
+hvcNAQcBoIICKzCCAicwggHOoAMCAQICFFpMnX2x5kYCnmNFcfOb2BR49zPdMAoG
+CCqGSM49BAMCMDcxGDAWBgNVBAoMD0dvbW1pdGxpbnQgVGVzdDEbMBkGA1UEAwwS
+R29tbWl0bGludCBUZXN0IENBMCAXDTI2MTAxOTE0MzUyNFoYDzIxMjYwOTI1MTQz
+NTI0WjBPMRgwFgYDVQQKDA9Hb21taXRsaW50IFRlc3QxEjAQBgNVBAMMCVRlc3Qg
+VXNlcjEfMB0GCSqGSIb3DQEJARYQdGVzdEBleGFtcGxlLmNvbTBZMBMGByqGSM49
+AgEGCCqGSM49AwEHA0IABLonnK3xK8xyHsv8NdM9vFA026PpM5/ceLKHy4KZs8aw
+BRbd3HbfnlRJ7IC9ZXcYqNkxAQg2cYVl1bPHXkuydiWjgZ0wgZowDAYDVR0TAQH/
+BAIwADAOBgNVHQ8BAf8EBAMCB4AwHQYDVR0lBBYwFAYIKwYBBQUHAwQGCCsGAQUF
+BwMDMBsGA1UdEQQUMBKBEHRlc3RAZXhhbXBsZS5jb20wHQYDVR0OBBYEFMvFlJwN
+T1zhmYBywqKUmIO+vm8JMB8GA1UdIwQYMBaAFNKhVViA1RDDSakoUvCbVd+StxVz
+MAoGCCqGSM49BAMCA0cAMEQCIHOYsx4NU3RN03AOD1+XNd2sEfNZSONO3z8s5LR0
+k091AiBE4qzZN/dPMjGSpqGeA9GiAo2OljyUHAUwdojBJgNg6TGCASUwggEhAgEB
+ME8wNzEYMBYGA1UECgwPR29tbWl0bGludCBUZXN0MRswGQYDVQQDDBJHb21taXRs
+aW50IFRlc3QgQ0ECFFpMnX2x5kYCnmNFcfOb2BR49zPdMAsGCWCGSAFlAwQCAaBp
+MBgGCSqGSIb3DQEJAzELBgkqhkiG9w0BBwEwHAYJKoZIhvcNAQkFMQ8XDTI2MTAx
+OTE0NDEwNlowLwYJKoZIhvcNAQkEMSIEICzyTbpfsKMOJug7KsW54p4bFh5cH6dC
+XnMEM2KTi5gkMAoGCCqGSM49BAMCBEcwRQIhAKvUYN8vsA2QxNdT/zXPj5IP6R65
+mjw4wCRS4hh4HXktAiAFezHquynmlcssVrC5Q2g0UMCZHakysltlqnuQEerweg==
+-----END SIGNED MESSAGE-----`
+
 	tests := []struct {
 		name         string
 		signature    string
@@ -58,6 +81,11 @@ FxlS+hzWnbOPMrRKuSfJ+H8mF6t1V3qUYtxHNQvHtcCvG0gx4auPSoxp7qVCVQ==
 			signature:   validSSHSignature,
 			expectError: false,
 		},
+		{
+			name:        "Valid x509 signature",
+			signature:   validX509Signature,
+			expectError: false,
+		},
 		{
 			name:         "Missing signature",
 			signature:    "",
@@ -128,6 +156,27 @@ FxlS+hzWnbOPMrRKuSfJ+H8mF6t1V3qUYtxHNQvHtcCvG0gx4auPSoxp7qVCVQ==
 			errorCode:    "invalid_gpg_format",
 			errorMessage: "malformed GPG signature",
 		},
+		{
+			name:         "Incomplete x509 signature - missing end",
+			signature:    "-----BEGIN SIGNED MESSAGE-----\nMIIDigYJKoZIhvcNAQcCoIIDezCCA3cCAQEx...",
+			expectError:  true,
+			errorCode:    "incomplete_x509_signature",
+			errorMessage: "incomplete X.509 signature",
+		},
+		{
+			name:         "Malformed x509 signature - not CMS",
+			signature:    "-----BEGIN SIGNED MESSAGE-----\nU1NIU0lHAA==\n-----END SIGNED MESSAGE-----",
+			expectError:  true,
+			errorCode:    "invalid_x509_format",
+			errorMessage: "malformed X.509 signature",
+		},
+		{
+			name:         "Truncated x509 signature",
+			signature:    "-----BEGIN SIGNED MESSAGE-----\nMIIDig==\n-----END SIGNED MESSAGE-----",
+			expectError:  true,
+			errorCode:    "invalid_x509_format",
+			errorMessage: "malformed X.509 signature",
+		},
 		{
 			name:         "SSH signature with wrong marker",
 			signature:    "-----BEGIN SSH KEY-----\nU1NIU0lHAA==\n-----END SSH KEY-----",
@@ -172,7 +221,8 @@ FxlS+hzWnbOPMrRKuSfJ+H8mF6t1V3qUYtxHNQvHtcCvG0gx4auPSoxp7qVCVQ==
 
 				// Verify context information exists for specific error types
 				if tabletest.errorCode == "invalid_gpg_format" ||
-					tabletest.errorCode == "invalid_ssh_format" {
+					tabletest.errorCode == "invalid_ssh_format" ||
+					tabletest.errorCode == "invalid_x509_format" {
 					assert.Contains(t, rule.Errors()[0].Context, "signature_type",
 						"Context should contain signature type")
 				}
@@ -193,6 +243,9 @@ FxlS+hzWnbOPMrRKuSfJ+H8mF6t1V3qUYtxHNQvHtcCvG0gx4auPSoxp7qVCVQ==
 				case "incomplete_ssh_signature":
 					assert.Contains(t, helpText, "SSH signature is incomplete",
 						"Help should explain the issue with the SSH signature")
+				case "incomplete_x509_signature":
+					assert.Contains(t, helpText, "X.509 signature is incomplete",
+						"Help should explain the issue with the X.509 signature")
 				}
 
 				// Verify Result() method is consistent
@@ -6,15 +6,21 @@
 Package signedidentityrule provides cryptographic signature verification for Git
 commits to enhance security and establish authorship.
 
-This package implements a comprehensive validation system for both GPG and SSH
-signatures on Git commits. It verifies that commits are cryptographically signed
+This package implements a comprehensive validation system for GPG, SSH,
+X.509 (gpgsm/S/MIME) and Sigstore (gitsign) signatures on Git commits. It verifies that commits are cryptographically signed
 by trusted keys, establishing a secure chain of authorship and preventing
 unauthorized code modifications.
 
 The package offers:
 
-  - Automatic detection of signature types (GPG or SSH)
+  - Automatic detection of signature types (GPG, SSH, X.509 or Sigstore)
   - Validation against trusted public keys stored in a specified directory
+  - Validation of X.509 certificate chains against a CA bundle (WithCABundle),
+    including validity period, key usage (email protection or code signing) and
+    committer email checks
+  - Offline verification of gitsign keyless signatures (WithSigstore): the embedded
+    Rekor log entry is checked against trusted Rekor keys, the Fulcio certificate
+    chain at the logged time, and the OIDC subject and issuer against an allowlist
   - Security checks for key strength, expiration, and revocation status
   - Support for multiple key formats and encodings
 
@@ -26,16 +32,21 @@ Key components include:
   - VerifySignatureIdentity: The main validation function that detects signature
     type and dispatches to the appropriate verification method.
 
-  - Helper functions for GPG and SSH signature verification, key loading,
+  - Helper functions for GPG, SSH, X.509 and Sigstore signature verification, key loading,
     and security validation.
 
 The rule enforces the following security policies:
 
   - RSA keys must meet minimum bit length requirements (default: 2048 bits)
   - EC keys must meet minimum security requirements (default: 256 bits)
+  - Only rsa, ecdsa and ed25519 keys are accepted by default
   - Expired or revoked keys are rejected
   - Only recognized signature formats are accepted
 
+These limits, and the accepted signature hash algorithms, can be changed with a
+KeyPolicy passed through WithKeyPolicy. A key that verifies the signature but
+violates the policy is reported with its own error code rather than as untrusted.
+
 Note: This package is being gradually migrated to the main "rule" package.
 New code should use the equivalent functionality in package "rule" instead.
 
@@ -8,10 +8,12 @@ import (
 	"errors"
 	"fmt"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/ProtonMail/go-crypto/openpgp"
+	"github.com/ProtonMail/go-crypto/openpgp/armor"
 	"github.com/ProtonMail/go-crypto/openpgp/packet"
 )
 
@@ -21,20 +23,21 @@ import (
 //   - commitData: The raw commit data to verify
 //   - signature: The GPG signature in ASCII-armored format
 //   - keyDir: Directory containing trusted public keys
+//   - policy: The key and hash algorithm policy the signing key must satisfy
 //
 // The function attempts to verify the signature against all trusted GPG keys found
 // in the specified directory. It performs several security checks on each key:
 //  1. Skips revoked keys
 //  2. Skips expired keys
-//  3. Skips keys that don't meet minimum strength requirements
+//  3. Rejects keys that verify the signature but violate the key policy
 //
-// This comprehensive validation ensures that commits are only verified against
-// currently valid and sufficiently secure keys.
+// A key that verifies the signature but is rejected by the policy is reported with a
+// *KeyPolicyError, so callers can tell it apart from a signature made by an untrusted key.
 //
 // Returns:
 //   - string: The identity associated with the key that verified the signature
 //   - error: Any error encountered during verification, or if no key verified the signature
-func verifyGPGSignature(commitData []byte, signature string, keyDir string) (string, error) {
+func verifyGPGSignature(commitData []byte, signature string, keyDir string, policy KeyPolicy) (string, error) {
 	if signature == "" {
 		return "", errors.New("empty GPG signature")
 	}
@@ -49,6 +52,12 @@ func verifyGPGSignature(commitData []byte, signature string, keyDir string) (str
 		return "", fmt.Errorf("no GPG key files found in %s", keyDir)
 	}
 
+	// The signature packet tells us which hash and which (sub)key were used.
+	// A parse failure is not fatal here; verification below will fail anyway.
+	sigPacket, _ := readGPGSignaturePacket(signature)
+
+	var policyErr error
+
 	// Try each key file
 	for _, keyFile := range keyFiles {
 		entities, err := loadGPGKey(keyFile)
@@ -68,11 +77,6 @@ func verifyGPGSignature(commitData []byte, signature string, keyDir string) (str
 				continue
 			}
 
-			// Skip keys that don't meet minimum strength requirements
-			if !hasMinimumKeyStrength(entity) {
-				continue
-			}
-
 			dataReader := strings.NewReader(string(commitData))
 			sigReader := strings.NewReader(signature)
 
@@ -83,20 +87,76 @@ func verifyGPGSignature(commitData []byte, signature string, keyDir string) (str
 				nil,
 			)
 
-			if err == nil && verifiedEntity != nil {
-				// Found a matching key
-				for name := range verifiedEntity.Identities {
-					return name, nil
+			if err != nil || verifiedEntity == nil {
+				continue
+			}
+
+			// The key matches, but it must also satisfy the key policy
+			if err := checkGPGKeyPolicy(signingPublicKey(entity, sigPacket), sigPacket, policy); err != nil {
+				if policyErr == nil {
+					policyErr = err
 				}
 
-				return filepath.Base(keyFile), nil
+				continue
+			}
+
+			// Found a matching key
+			for name := range verifiedEntity.Identities {
+				return name, nil
 			}
+
+			return filepath.Base(keyFile), nil
 		}
 	}
 
+	if policyErr != nil {
+		return "", policyErr
+	}
+
 	return "", errors.New("GPG signature not verified with any trusted key")
 }
 
+// readGPGSignaturePacket decodes an ASCII-armored detached signature into its packet.
+//
+// Parameters:
+//   - signature: The GPG signature in ASCII-armored format
+//
+// Returns:
+//   - *packet.Signature: The parsed signature packet
+//   - error: Any error encountered while decoding or parsing
+func readGPGSignaturePacket(signature string) (*packet.Signature, error) {
+	block, err := armor.Decode(strings.NewReader(signature))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode GPG signature armor: %w", err)
+	}
+
+	pkt, err := packet.Read(block.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read GPG signature packet: %w", err)
+	}
+
+	sig, ok := pkt.(*packet.Signature)
+	if !ok {
+		return nil, errors.New("GPG armor block does not contain a signature packet")
+	}
+
+	return sig, nil
+}
+
+// signingPublicKey returns the key of entity that issued sig.
+// It falls back to the primary key when the issuer cannot be determined.
+func signingPublicKey(entity *openpgp.Entity, sig *packet.Signature) *packet.PublicKey {
+	if sig != nil && sig.IssuerKeyId != nil {
+		for _, subkey := range entity.Subkeys {
+			if subkey.PublicKey != nil && subkey.PublicKey.KeyId == *sig.IssuerKeyId {
+				return subkey.PublicKey
+			}
+		}
+	}
+
+	return entity.PrimaryKey
+}
+
 // loadGPGKey loads a GPG key from a file, supporting both armored and binary formats.
 //
 // Parameters:
@@ -207,56 +267,59 @@ func isKeyExpired(entity *openpgp.Entity, now time.Time) bool {
 	return false
 }
 
-// hasMinimumKeyStrength checks if a GPG key meets the minimum strength requirements.
+// checkGPGKeyPolicy checks a GPG signing key and signature against the key policy.
 //
 // Parameters:
-//   - entity: The GPG key entity to check
-//
-// The function evaluates the cryptographic strength of the key based on its algorithm
-// and bit length:
-//   - For RSA keys, it compares against MinimumRSABits (default: 2048)
-//   - For EC keys, it compares against MinimumECBits (default: 256)
-//   - If bit length can't be determined, it takes a conservative approach
-//   - Other algorithm types are rejected by default
+//   - publicKey: The GPG key that produced the signature
+//   - sig: The signature packet, used for the hash algorithm (may be nil)
+//   - policy: The key policy to enforce
 //
-// This ensures that only keys with sufficient cryptographic strength are used for
-// verification, protecting against the use of weak or outdated keys.
+// The function evaluates the key algorithm, its bit length and the signature hash:
+//   - The algorithm must be in policy.AllowedAlgorithms (default: rsa, ecdsa, ed25519)
+//   - RSA keys are compared against policy.MinRSABits (default: 2048)
+//   - EC keys are compared against policy.MinECBits (default: 256)
+//   - The hash must be in policy.AllowedHashAlgorithms when that list is set
 //
 // Returns:
-//   - bool: true if the key meets minimum strength requirements, false otherwise
-func hasMinimumKeyStrength(entity *openpgp.Entity) bool {
-	// Check RSA keys against minimum bit length
-	if entity.PrimaryKey.PubKeyAlgo == packet.PubKeyAlgoRSA ||
-		entity.PrimaryKey.PubKeyAlgo == packet.PubKeyAlgoRSAEncryptOnly ||
-		entity.PrimaryKey.PubKeyAlgo == packet.PubKeyAlgoRSASignOnly {
-		bitLength, err := entity.PrimaryKey.BitLength()
-		if err != nil {
-			return false // If we can't determine bit length, reject for safety
-		}
+//   - error: A *KeyPolicyError describing the violation, or nil if the key is acceptable
+func checkGPGKeyPolicy(publicKey *packet.PublicKey, sig *packet.Signature, policy KeyPolicy) error {
+	algorithm, bits := gpgKeyAlgorithm(publicKey)
 
-		return bitLength >= MinimumRSABits
+	if err := policy.checkKey(GPG, algorithm, bits); err != nil {
+		return err
 	}
 
-	// For EC keys
-	if entity.PrimaryKey.PubKeyAlgo == packet.PubKeyAlgoECDSA ||
-		entity.PrimaryKey.PubKeyAlgo == packet.PubKeyAlgoEdDSA ||
-		entity.PrimaryKey.PubKeyAlgo == packet.PubKeyAlgoECDH {
-		// Try to get bit length directly
-		bitLength, err := entity.PrimaryKey.BitLength()
-		if err == nil {
-			return bitLength >= MinimumECBits
-		}
+	if sig != nil {
+		return policy.checkHash(GPG, hashName(sig.Hash))
+	}
 
-		// If BitLength() failed, fall back to algorithm-specific checks
-		if entity.PrimaryKey.PubKeyAlgo == packet.PubKeyAlgoEdDSA {
-			return 256 >= MinimumECBits // Ed25519 is always 256 bits
-		}
+	return nil
+}
 
-		// For other EC types without bit length info, assume minimum standards
-		// This is a conservative approach
-		return false
+// gpgKeyAlgorithm returns the normalised algorithm name and bit length of a GPG key.
+// The bit length is 0 when it cannot be determined.
+func gpgKeyAlgorithm(publicKey *packet.PublicKey) (string, int) {
+	bits := 0
+	if bitLength, err := publicKey.BitLength(); err == nil {
+		bits = int(bitLength)
 	}
 
-	// Reject any other algorithms
-	return false
+	switch publicKey.PubKeyAlgo { //nolint:exhaustive
+	case packet.PubKeyAlgoRSA, packet.PubKeyAlgoRSAEncryptOnly, packet.PubKeyAlgoRSASignOnly:
+		return AlgorithmRSA, bits
+	case packet.PubKeyAlgoDSA:
+		return AlgorithmDSA, bits
+	case packet.PubKeyAlgoElGamal:
+		return AlgorithmElGamal, bits
+	case packet.PubKeyAlgoECDSA:
+		return AlgorithmECDSA, bits
+	case packet.PubKeyAlgoEdDSA, packet.PubKeyAlgoEd25519:
+		return AlgorithmEd25519, 256 // Ed25519 is always 256 bits
+	case packet.PubKeyAlgoEd448:
+		return AlgorithmEd448, 448
+	case packet.PubKeyAlgoECDH, packet.PubKeyAlgoX25519, packet.PubKeyAlgoX448:
+		return AlgorithmECDH, bits
+	default:
+		return "unknown-" + strconv.Itoa(int(publicKey.PubKeyAlgo)), bits
+	}
 }
@@ -4,9 +4,11 @@
 package signedidentityrule
 
 import (
+	"crypto"
 	"path/filepath"
 	"testing"
 
+	"github.com/ProtonMail/go-crypto/openpgp/packet"
 	"github.com/stretchr/testify/require"
 )
 
@@ -117,13 +119,101 @@ func TestIsKeyExpired(t *testing.T) {
 	})
 }
 
-func TestHasMinimumKeyStrength(t *testing.T) {
-	// This would test the key strength validation logic
-	t.Run("strong enough key", func(t *testing.T) {
-		// Skip test for now since we need proper test keys with known strengths
-		// Example of what a real test might look like:
-		// entity := loadTestKey(t, "valid.priv")
-		// require.True(t, hasMinimumKeyStrength(entity), "Key should meet minimum strength requirements")
-		t.Skip("Requires proper GPG key strength mocks")
+func TestCheckGPGKeyPolicy(t *testing.T) {
+	// The test key is a 2048-bit RSA key
+	publicKey := loadTestKey(t).PrimaryKey
+
+	tests := []struct {
+		name       string
+		policy     KeyPolicy
+		hash       crypto.Hash
+		wantReason string
+	}{
+		{
+			name:   "default policy accepts RSA 2048",
+			policy: DefaultKeyPolicy(),
+			hash:   crypto.SHA256,
+		},
+		{
+			name:       "RSA key below configured minimum",
+			policy:     KeyPolicy{MinRSABits: 3072}.withDefaults(),
+			hash:       crypto.SHA256,
+			wantReason: PolicyReasonWeakKey,
+		},
+		{
+			name:       "algorithm not in allowlist",
+			policy:     KeyPolicy{AllowedAlgorithms: []string{"ed25519"}}.withDefaults(),
+			hash:       crypto.SHA256,
+			wantReason: PolicyReasonDisallowedAlgorithm,
+		},
+		{
+			name:       "hash not in allowlist",
+			policy:     KeyPolicy{AllowedHashAlgorithms: []string{"sha512"}}.withDefaults(),
+			hash:       crypto.SHA1,
+			wantReason: PolicyReasonDisallowedHash,
+		},
+		{
+			name:   "hash allowlist is case insensitive",
+			policy: KeyPolicy{AllowedHashAlgorithms: []string{"SHA256"}}.withDefaults(),
+			hash:   crypto.SHA256,
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			err := checkGPGKeyPolicy(publicKey, &packet.Signature{Hash: tabletest.hash}, tabletest.policy)
+
+			if tabletest.wantReason == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			var policyErr *KeyPolicyError
+			require.ErrorAs(t, err, &policyErr)
+			require.Equal(t, tabletest.wantReason, policyErr.Reason)
+			require.Equal(t, GPG, policyErr.KeyType)
+		})
+	}
+}
+
+func TestVerifySignatureIdentityKeyPolicy(t *testing.T) {
+	testDataDir, err := filepath.Abs("testdata")
+	require.NoError(t, err)
+
+	_, commit := setupTestRepo(t, setupRepoOptions{
+		authorName:  "Test User",
+		authorEmail: "test@example.com",
+		message:     "Signed commit",
+		signKey:     loadTestKey(t),
+	})
+
+	t.Run("weak key is reported separately from untrusted key", func(t *testing.T) {
+		result := VerifySignatureIdentity(commit, commit.PGPSignature, testDataDir,
+			WithKeyPolicy(KeyPolicy{MinRSABits: 4096}))
+
+		require.Len(t, result.Errors(), 1)
+		require.Equal(t, "weak_key", result.Errors()[0].Code)
+		require.Equal(t, "2048", result.Errors()[0].Context["key_bits"])
+		require.Equal(t, "4096", result.Errors()[0].Context["required_bits"])
+		require.Contains(t, result.VerboseResult(), "Weak GPG key detected: 2048 bits")
+	})
+
+	t.Run("disallowed algorithm", func(t *testing.T) {
+		result := VerifySignatureIdentity(commit, commit.PGPSignature, testDataDir,
+			WithKeyPolicy(KeyPolicy{AllowedAlgorithms: []string{"ed25519"}}))
+
+		require.Len(t, result.Errors(), 1)
+		require.Equal(t, "disallowed_key_algorithm", result.Errors()[0].Code)
+		require.Equal(t, "rsa", result.Errors()[0].Context["algorithm"])
+		require.Contains(t, result.Help(), "does not allow")
+	})
+
+	t.Run("compliant key passes", func(t *testing.T) {
+		result := VerifySignatureIdentity(commit, commit.PGPSignature, testDataDir,
+			WithKeyPolicy(KeyPolicy{MinRSABits: 2048, AllowedAlgorithms: []string{"rsa"}}))
+
+		require.Empty(t, result.Errors())
+		require.Equal(t, "Test User <test@example.com>", result.Identity)
 	})
 }
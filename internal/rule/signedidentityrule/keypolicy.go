@@ -0,0 +1,213 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package signedidentityrule
+
+import (
+	"crypto"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Key algorithm names used by KeyPolicy.AllowedAlgorithms.
+const (
+	AlgorithmRSA     = "rsa"
+	AlgorithmDSA     = "dsa"
+	AlgorithmECDSA   = "ecdsa"
+	AlgorithmEd25519 = "ed25519"
+	AlgorithmEd448   = "ed448"
+	AlgorithmElGamal = "elgamal"
+	AlgorithmECDH    = "ecdh"
+)
+
+// Reasons for rejecting a key that verified a signature but violates the policy.
+const (
+	PolicyReasonWeakKey              = "weak_key"
+	PolicyReasonDisallowedAlgorithm  = "disallowed_key_algorithm"
+	PolicyReasonDisallowedHash       = "disallowed_hash_algorithm"
+	PolicyReasonUnknownKeyProperties = "unknown_key_properties"
+)
+
+// DefaultAllowedAlgorithms lists the key algorithms accepted when no explicit
+// allowlist is configured. These are the algorithms the key strength check accepted
+// before the policy was configurable, so DSA and Ed448 keys must be allowed explicitly.
+var DefaultAllowedAlgorithms = []string{AlgorithmRSA, AlgorithmECDSA, AlgorithmEd25519}
+
+// KeyPolicy describes which signing keys and signature hashes are acceptable.
+//
+// A zero value for a numeric field means the package default is used, and an
+// empty AllowedHashAlgorithms list means any hash algorithm is accepted.
+//
+// Example:
+//
+//	policy := KeyPolicy{
+//	    MinRSABits:            3072,
+//	    AllowedAlgorithms:     []string{"ed25519"},
+//	    AllowedHashAlgorithms: []string{"sha256", "sha512"},
+//	}
+type KeyPolicy struct {
+	// MinRSABits is the minimum modulus size for RSA keys.
+	MinRSABits int
+
+	// MinECBits is the minimum curve size for elliptic curve keys.
+	MinECBits int
+
+	// AllowedAlgorithms lists the permitted key algorithms (rsa, dsa, ecdsa, ed25519, ed448).
+	AllowedAlgorithms []string
+
+	// AllowedHashAlgorithms lists the permitted signature hashes (sha1, sha256, sha384, sha512, ...).
+	AllowedHashAlgorithms []string
+}
+
+// DefaultKeyPolicy returns the policy applied when nothing is configured.
+func DefaultKeyPolicy() KeyPolicy {
+	return KeyPolicy{
+		MinRSABits:        int(MinimumRSABits),
+		MinECBits:         int(MinimumECBits),
+		AllowedAlgorithms: DefaultAllowedAlgorithms,
+	}
+}
+
+// withDefaults fills in unset fields from DefaultKeyPolicy.
+func (p KeyPolicy) withDefaults() KeyPolicy {
+	defaults := DefaultKeyPolicy()
+
+	if p.MinRSABits <= 0 {
+		p.MinRSABits = defaults.MinRSABits
+	}
+
+	if p.MinECBits <= 0 {
+		p.MinECBits = defaults.MinECBits
+	}
+
+	if len(p.AllowedAlgorithms) == 0 {
+		p.AllowedAlgorithms = defaults.AllowedAlgorithms
+	}
+
+	return p
+}
+
+// KeyPolicyError reports a key that verified the signature but was rejected by the policy.
+// It is kept distinct from an untrusted key so callers can tell the two situations apart.
+type KeyPolicyError struct {
+	Reason       string // One of the PolicyReason constants
+	KeyType      string // "GPG" or "SSH"
+	Algorithm    string // Normalised key algorithm name
+	Bits         int    // Key size in bits, when known
+	RequiredBits int    // Minimum size required by the policy, for weak keys
+	Hash         string // Normalised signature hash name, when relevant
+	Allowed      []string
+}
+
+// Error implements the error interface.
+func (e *KeyPolicyError) Error() string {
+	switch e.Reason {
+	case PolicyReasonWeakKey:
+		return fmt.Sprintf("%s key strength: %d bits (required: %d bits)", e.KeyType, e.Bits, e.RequiredBits)
+	case PolicyReasonDisallowedAlgorithm:
+		return fmt.Sprintf("%s key algorithm %q is not allowed (allowed: %s)", e.KeyType, e.Algorithm, strings.Join(e.Allowed, ", "))
+	case PolicyReasonDisallowedHash:
+		return fmt.Sprintf("%s signature hash %q is not allowed (allowed: %s)", e.KeyType, e.Hash, strings.Join(e.Allowed, ", "))
+	default:
+		return fmt.Sprintf("%s key properties for algorithm %q could not be determined", e.KeyType, e.Algorithm)
+	}
+}
+
+// checkKey validates an algorithm and key size against the policy.
+// A bits value of 0 means the size could not be determined.
+func (p KeyPolicy) checkKey(keyType, algorithm string, bits int) error {
+	if !containsFold(p.AllowedAlgorithms, algorithm) {
+		return &KeyPolicyError{
+			Reason:    PolicyReasonDisallowedAlgorithm,
+			KeyType:   keyType,
+			Algorithm: algorithm,
+			Bits:      bits,
+			Allowed:   p.AllowedAlgorithms,
+		}
+	}
+
+	var required int
+
+	switch algorithm {
+	case AlgorithmRSA:
+		required = p.MinRSABits
+	case AlgorithmECDSA, AlgorithmEd25519, AlgorithmEd448:
+		required = p.MinECBits
+	default:
+		return nil
+	}
+
+	if bits == 0 {
+		return &KeyPolicyError{
+			Reason:    PolicyReasonUnknownKeyProperties,
+			KeyType:   keyType,
+			Algorithm: algorithm,
+		}
+	}
+
+	if bits < required {
+		return &KeyPolicyError{
+			Reason:       PolicyReasonWeakKey,
+			KeyType:      keyType,
+			Algorithm:    algorithm,
+			Bits:         bits,
+			RequiredBits: required,
+		}
+	}
+
+	return nil
+}
+
+// checkHash validates a signature hash against the policy.
+// An empty hash name means the hash is implied by the key algorithm and is not checked.
+func (p KeyPolicy) checkHash(keyType, hash string) error {
+	if len(p.AllowedHashAlgorithms) == 0 || hash == "" {
+		return nil
+	}
+
+	if containsFold(p.AllowedHashAlgorithms, hash) {
+		return nil
+	}
+
+	return &KeyPolicyError{
+		Reason:  PolicyReasonDisallowedHash,
+		KeyType: keyType,
+		Hash:    hash,
+		Allowed: p.AllowedHashAlgorithms,
+	}
+}
+
+// hashName returns the normalised policy name of a crypto.Hash.
+func hashName(hash crypto.Hash) string {
+	switch hash { //nolint:exhaustive
+	case crypto.MD5:
+		return "md5"
+	case crypto.RIPEMD160:
+		return "ripemd160"
+	case crypto.SHA1:
+		return "sha1"
+	case crypto.SHA224:
+		return "sha224"
+	case crypto.SHA256:
+		return "sha256"
+	case crypto.SHA384:
+		return "sha384"
+	case crypto.SHA512:
+		return "sha512"
+	case crypto.SHA3_256:
+		return "sha3-256"
+	case crypto.SHA3_512:
+		return "sha3-512"
+	default:
+		return strings.ToLower(hash.String())
+	}
+}
+
+// containsFold reports whether values contains target, ignoring case.
+func containsFold(values []string, target string) bool {
+	return slices.ContainsFunc(values, func(value string) bool {
+		return strings.EqualFold(strings.TrimSpace(value), target)
+	})
+}
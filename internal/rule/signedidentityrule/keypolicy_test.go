@@ -0,0 +1,70 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+package signedidentityrule
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyPolicyCheckKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		policy     KeyPolicy
+		algorithm  string
+		bits       int
+		wantReason string
+	}{
+		{
+			name:      "Default policy accepts RSA 2048",
+			policy:    DefaultKeyPolicy(),
+			algorithm: AlgorithmRSA,
+			bits:      2048,
+		},
+		{
+			name:       "Default policy rejects weak RSA",
+			policy:     DefaultKeyPolicy(),
+			algorithm:  AlgorithmRSA,
+			bits:       1024,
+			wantReason: PolicyReasonWeakKey,
+		},
+		{
+			name:      "Default policy accepts Ed25519",
+			policy:    DefaultKeyPolicy(),
+			algorithm: AlgorithmEd25519,
+			bits:      256,
+		},
+		{
+			name:       "Default policy rejects DSA as before",
+			policy:     DefaultKeyPolicy(),
+			algorithm:  AlgorithmDSA,
+			bits:       3072,
+			wantReason: PolicyReasonDisallowedAlgorithm,
+		},
+		{
+			name:      "DSA accepted when allowed",
+			policy:    KeyPolicy{AllowedAlgorithms: []string{AlgorithmRSA, AlgorithmDSA}}.withDefaults(),
+			algorithm: AlgorithmDSA,
+			bits:      3072,
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			err := tabletest.policy.checkKey("GPG", tabletest.algorithm, tabletest.bits)
+
+			if tabletest.wantReason == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			var policyErr *KeyPolicyError
+			require.True(t, errors.As(err, &policyErr))
+			require.Equal(t, tabletest.wantReason, policyErr.Reason)
+		})
+	}
+}
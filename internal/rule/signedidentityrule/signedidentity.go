@@ -5,7 +5,9 @@
 package signedidentityrule
 
 import (
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/go-git/go-git/v5/plumbing/object"
@@ -16,18 +18,22 @@ var MinimumRSABits uint16 = 2048
 var MinimumECBits uint16 = 256
 
 const (
-	SSH = "SSH"
-	GPG = "GPG"
+	SSH      = "SSH"
+	GPG      = "GPG"
+	X509     = "X509"
+	Sigstore = "Sigstore"
 )
 
-// SignedIdentity validates that a commit is properly signed with either GPG or SSH.
+// SignedIdentity validates that a commit is properly signed with GPG, SSH, X.509 (S/MIME)
+// or Sigstore (gitsign keyless signing).
 // This rule helps ensure that code changes are securely authenticated and attributable
 // to a verified identity, which is crucial for maintaining supply chain security and
 // establishing an audit trail of code changes.
 //
 // The rule checks:
-// - Whether the commit has a cryptographic signature (GPG or SSH)
-// - If the signature can be verified against trusted keys
+// - Whether the commit has a cryptographic signature (GPG, SSH, X.509 or Sigstore)
+// - If the signature can be verified against trusted keys, or for X.509 against a CA bundle
+// - For Sigstore, that the signature is in the transparency log and made by an allowed identity
 // - That the key used for signing meets minimum security requirements
 //
 // Example usage:
@@ -47,9 +53,39 @@ const (
 //	}
 type SignedIdentity struct {
 	errors        []*model.ValidationError
-	Identity      string // Email or name of the signer
-	SignatureType string // "GPG" or "SSH"
-	KeyDir        string // Directory used for key verification
+	Identity      string          // Email or name of the signer
+	SignatureType string          // "GPG", "SSH", "X509" or "Sigstore"
+	KeyDir        string          // Directory used for key verification
+	CABundle      string          // PEM file with trusted CA certificates for X.509 signatures
+	Sigstore      *SigstoreConfig // Trusted root and allowed identities for Sigstore signatures
+	Policy        KeyPolicy       // Key and hash algorithm policy applied to the signing key
+}
+
+// Option configures a SignedIdentity verification.
+type Option func(*SignedIdentity)
+
+// WithKeyPolicy sets the key policy that a verifying key must satisfy.
+// Unset fields in policy fall back to DefaultKeyPolicy.
+func WithKeyPolicy(policy KeyPolicy) Option {
+	return func(s *SignedIdentity) {
+		s.Policy = policy.withDefaults()
+	}
+}
+
+// WithCABundle sets the PEM file of trusted CA certificates used to verify
+// X.509 (gpgsm/S/MIME) signatures.
+func WithCABundle(path string) Option {
+	return func(s *SignedIdentity) {
+		s.CABundle = path
+	}
+}
+
+// WithSigstore enables offline verification of Sigstore (gitsign) keyless signatures
+// against a trusted Fulcio and Rekor root and an allowlist of OIDC identities.
+func WithSigstore(config SigstoreConfig) Option {
+	return func(s *SignedIdentity) {
+		s.Sigstore = &config
+	}
 }
 
 // Name returns the rule identifier.
@@ -72,6 +108,8 @@ func (s SignedIdentity) VerboseResult() string {
 		switch s.errors[0].Code {
 		case "commit_nil":
 			return "Cannot verify signature: commit object is nil"
+		case "tag_nil":
+			return "Cannot verify signature: tag object is nil"
 		case "no_key_dir":
 			return "Cannot verify signature: no trusted key directory provided"
 		case "invalid_key_dir":
@@ -114,6 +152,14 @@ func (s SignedIdentity) VerboseResult() string {
 			}
 
 			return "Weak " + s.SignatureType + " key detected: " + bits + " bits (minimum required: " + required + " bits)"
+		case PolicyReasonDisallowedAlgorithm:
+			return "Signature made with a trusted " + s.SignatureType + " key, but key algorithm '" +
+				s.errors[0].Context["algorithm"] + "' is not allowed by the key policy (allowed: " + s.errors[0].Context["allowed"] + ")"
+		case PolicyReasonDisallowedHash:
+			return "Signature made with a trusted " + s.SignatureType + " key, but hash algorithm '" +
+				s.errors[0].Context["hash_algorithm"] + "' is not allowed by the key policy (allowed: " + s.errors[0].Context["allowed"] + ")"
+		case PolicyReasonUnknownKeyProperties:
+			return "Signature made with a trusted " + s.SignatureType + " key, but its strength could not be determined"
 		case "verification_failed":
 			var errorMsg string
 
@@ -126,6 +172,31 @@ func (s SignedIdentity) VerboseResult() string {
 			}
 
 			return "Signature verification failed: " + errorMsg
+		case "no_ca_bundle":
+			return "Cannot verify X.509 signature: no CA bundle provided"
+		case "invalid_ca_bundle":
+			return "Cannot verify X.509 signature: invalid CA bundle - " + s.errors[0].Context["error"]
+		case "certificate_untrusted":
+			return "X.509 signing certificate does not chain to a CA in the CA bundle"
+		case "certificate_expired":
+			return "X.509 signing certificate is expired or not yet valid (valid from " +
+				s.errors[0].Context["not_before"] + " to " + s.errors[0].Context["not_after"] + ")"
+		case "certificate_invalid":
+			return "X.509 signing certificate is not valid: " + s.errors[0].Context["error"]
+		case "email_mismatch":
+			return "X.509 signing certificate is issued to '" + s.errors[0].Context["certificate_emails"] +
+				"', not to committer email '" + s.errors[0].Context["committer_email"] + "'"
+		case "no_sigstore_root":
+			return "Cannot verify Sigstore signature: no Fulcio roots and Rekor public keys provided"
+		case "invalid_sigstore_root":
+			return "Cannot verify Sigstore signature: invalid trusted root - " + s.errors[0].Context["error"]
+		case "missing_tlog_entry":
+			return "Sigstore signature does not embed a Rekor transparency log entry, cannot verify it offline"
+		case "invalid_tlog_entry":
+			return "Sigstore transparency log entry does not verify: " + s.errors[0].Context["error"]
+		case "identity_not_allowed":
+			return "Sigstore signature made by '" + s.errors[0].Context["subject"] + "' (issuer '" +
+				s.errors[0].Context["issuer"] + "'), which is not an allowed identity"
 		case "unknown_signature_type":
 			return "Unknown signature type, cannot verify identity"
 		default:
@@ -164,6 +235,8 @@ func (s SignedIdentity) Help() string {
 		switch s.errors[0].Code {
 		case "commit_nil":
 			return "A valid commit object is required for signature verification"
+		case "tag_nil":
+			return "A valid annotated tag object is required for signature verification. Lightweight tags cannot be signed"
 		case "no_key_dir":
 			return "Please provide a valid directory containing trusted public keys for verification"
 		case "invalid_key_dir":
@@ -181,8 +254,43 @@ func (s SignedIdentity) Help() string {
 
 			return fmt.Sprintf("The %s key used for signing (%s bits) does not meet the minimum strength requirement of %s bits. Please generate a stronger key",
 				keyType, bits, required)
+		case PolicyReasonDisallowedAlgorithm:
+			return fmt.Sprintf("The %s key used for signing uses the %s algorithm, which the key policy does not allow. Sign with a key using one of: %s",
+				s.errors[0].Context["key_type"], s.errors[0].Context["algorithm"], s.errors[0].Context["allowed"])
+		case PolicyReasonDisallowedHash:
+			return fmt.Sprintf("The signature uses the %s hash algorithm, which the key policy does not allow. Configure your signing tool to use one of: %s\n"+
+				"For SSH RSA keys, git uses rsa-sha2-512 by default with recent OpenSSH versions",
+				s.errors[0].Context["hash_algorithm"], s.errors[0].Context["allowed"])
+		case PolicyReasonUnknownKeyProperties:
+			return "The strength of the signing key could not be determined, so it was rejected by the key policy. Please sign with a standard RSA, ECDSA or Ed25519 key"
 		case "verification_failed":
 			return "Signature verification failed. The signature may be invalid or the commit content may have been altered"
+		case "no_ca_bundle":
+			return "X.509 signatures are verified against a CA bundle. Configure 'signature: identity: ca-bundle' with a PEM file of trusted CA certificates"
+		case "invalid_ca_bundle":
+			return "The configured CA bundle could not be read. Please provide a readable PEM file containing the trusted CA certificates"
+		case "certificate_untrusted":
+			return "The X.509 certificate used for signing was not issued by a trusted CA. Add the issuing CA certificate to the CA bundle, or sign with a certificate from a trusted CA"
+		case "certificate_expired":
+			return "The X.509 certificate used for signing is expired or not yet valid. Renew the certificate and sign the commit again"
+		case "certificate_invalid":
+			return "The X.509 certificate chain used for signing is not valid. Check that the certificate and its issuers are allowed to sign, and that the certificate has the email protection or code signing extended key usage"
+		case "email_mismatch":
+			return "The X.509 certificate used for signing is not issued to the committer email.\n" +
+				"Commit with the email address in the certificate, or use a certificate issued to your committer email"
+		case "no_sigstore_root":
+			return "Sigstore (gitsign) signatures are verified offline against a trusted root.\n" +
+				"Configure 'signature: identity: sigstore' with 'fulcio-roots', 'rekor-public-keys' and the allowed 'identities'"
+		case "invalid_sigstore_root":
+			return "The configured Sigstore trusted root could not be read. Provide a PEM file with the Fulcio certificates and a PEM file with the Rekor public keys"
+		case "missing_tlog_entry":
+			return "The Sigstore signature has no embedded Rekor log entry. Sign the commit again with a recent gitsign version, which stores the log entry in the signature"
+		case "invalid_tlog_entry":
+			return "The Rekor transparency log entry in the signature could not be verified. Check that the configured Rekor public keys belong to the log gitsign uploaded to"
+		case "identity_not_allowed":
+			return fmt.Sprintf("The commit was signed by %s (issuer %s), which is not an allowed identity.\n"+
+				"Sign with an allowed identity, or add it to 'signature: identity: sigstore: identities'",
+				s.errors[0].Context["subject"], s.errors[0].Context["issuer"])
 		}
 	}
 
@@ -192,23 +300,36 @@ func (s SignedIdentity) Help() string {
 			"Consider one of the following solutions:\n"+
 			"1. Sign your commits with GPG using 'git config --global commit.gpgsign true'\n"+
 			"2. Sign your commits with SSH using 'git config --global gpg.format ssh'\n"+
-			"3. Ensure your signing key is in the trusted keys directory\n"+
-			"4. Verify your key strength meets minimum requirements (RSA: %d bits, EC: %d bits)",
-		MinimumRSABits, MinimumECBits)
+			"3. Sign your commits with an X.509 certificate using 'git config --global gpg.format x509'\n"+
+			"4. Ensure your signing key is in the trusted keys directory, or its CA in the CA bundle\n"+
+			"5. Verify your key strength meets minimum requirements (RSA: %d bits, EC: %d bits)",
+		s.Policy.withDefaults().MinRSABits, s.Policy.withDefaults().MinECBits)
 }
 
 // VerifySignatureIdentity checks if a commit is signed with a trusted key.
-// It automatically detects whether the signature is GPG or SSH based on its format
-// and validates it against trusted public keys stored in the specified directory.
+// It automatically detects whether the signature is GPG, SSH or X.509 based on its format.
+// GPG and SSH signatures are validated against trusted public keys stored in the specified
+// directory. X.509 (CMS) signatures are validated against the CA bundle set with WithCABundle,
+// and Sigstore (gitsign) signatures offline against the trusted root set with WithSigstore.
+// keyDir is not required for X.509 and Sigstore signatures.
 //
 // The function performs several security checks:
 //   - Validates that the signature corresponds to the commit content
 //   - Verifies the signature against trusted keys in keyDir
-//   - Checks that the signing key meets minimum strength requirements
-//     (RSA: 2048 bits, EC: 256 bits by default)
-func VerifySignatureIdentity(commit *object.Commit, signature string, keyDir string) *SignedIdentity {
+//   - Checks that the signing key meets the key policy
+//     (RSA: 2048 bits, EC: 256 bits and rsa/ecdsa/ed25519 algorithms by default)
+//
+// A key that verifies the signature but violates the policy is reported with its own
+// error code (weak_key, disallowed_key_algorithm, disallowed_hash_algorithm) rather
+// than as an untrusted key.
+func VerifySignatureIdentity(commit *object.Commit, signature string, keyDir string, opts ...Option) *SignedIdentity {
 	rule := &SignedIdentity{
 		KeyDir: keyDir,
+		Policy: DefaultKeyPolicy(),
+	}
+
+	for _, opt := range opts {
+		opt(rule)
 	}
 
 	if commit == nil {
@@ -221,29 +342,81 @@ func VerifySignatureIdentity(commit *object.Commit, signature string, keyDir str
 		return rule
 	}
 
-	if keyDir == "" {
+	verifySignedData(rule, signature, keyDir, commit.Committer.Email, func() ([]byte, error) {
+		return getCommitBytes(commit)
+	})
+
+	return rule
+}
+
+// VerifyTagSignatureIdentity checks if an annotated tag is signed with a trusted key.
+// It accepts the same options and performs the same checks as VerifySignatureIdentity,
+// using the tagger email where the commit variant uses the committer email.
+func VerifyTagSignatureIdentity(tag *object.Tag, signature string, keyDir string, opts ...Option) *SignedIdentity {
+	rule := &SignedIdentity{
+		KeyDir: keyDir,
+		Policy: DefaultKeyPolicy(),
+	}
+
+	for _, opt := range opts {
+		opt(rule)
+	}
+
+	if tag == nil {
 		rule.addError(
-			"no_key_dir",
-			"no key directory provided",
+			"tag_nil",
+			"tag cannot be nil",
 			map[string]string{},
 		)
 
 		return rule
 	}
 
-	// Sanitize keyDir to prevent path traversal
-	sanitizedKeyDir, err := sanitizePath(keyDir)
-	if err != nil {
-		rule.addError(
-			"invalid_key_dir",
-			fmt.Sprintf("invalid key directory: %s", err),
-			map[string]string{
-				"key_dir": keyDir,
-				"error":   err.Error(),
-			},
-		)
+	verifySignedData(rule, signature, keyDir, tag.Tagger.Email, func() ([]byte, error) {
+		return getTagBytes(tag)
+	})
 
-		return rule
+	return rule
+}
+
+// verifySignedData verifies signature over the data of a signed git object and records
+// the outcome in rule. signerEmail is the committer or tagger email, which X.509
+// certificates must be issued to.
+func verifySignedData(rule *SignedIdentity, signature, keyDir, signerEmail string, signedData func() ([]byte, error)) {
+	// Auto-detect signature type
+	sigType := detectSignatureType(signature)
+	rule.SignatureType = sigType
+
+	// X.509 and Sigstore signatures are verified against certificate roots, not a key directory
+	var sanitizedKeyDir string
+
+	if sigType != X509 && sigType != Sigstore {
+		if keyDir == "" {
+			rule.addError(
+				"no_key_dir",
+				"no key directory provided",
+				map[string]string{},
+			)
+
+			return
+		}
+
+		// Sanitize keyDir to prevent path traversal
+		var err error
+
+		sanitizedKeyDir, err = sanitizePath(keyDir)
+		if err != nil {
+			rule.addError(
+				"invalid_key_dir",
+				fmt.Sprintf("invalid key directory: %s", err),
+				map[string]string{
+					"key_dir": keyDir,
+					"error":   err.Error(),
+				},
+			)
+
+			return
+		}
 	}
 
 	if signature == "" {
@@ -253,11 +426,11 @@ func VerifySignatureIdentity(commit *object.Commit, signature string, keyDir str
 			map[string]string{},
 		)
 
-		return rule
+		return
 	}
 
-	// Get commit data
-	commitBytes, err := getCommitBytes(commit)
+	// Get the signed data
+	commitBytes, err := signedData()
 	if err != nil {
 		rule.addError(
 			"commit_data_error",
@@ -267,19 +440,31 @@ func VerifySignatureIdentity(commit *object.Commit, signature string, keyDir str
 			},
 		)
 
-		return rule
+		return
 	}
 
-	// Auto-detect signature type
-	sigType := detectSignatureType(signature)
-	rule.SignatureType = sigType
-
 	// Helper function to handle verification errors
 	handleVerificationError := func(err error, sigType string) bool {
 		if err == nil {
 			return false
 		}
 
+		// Keys rejected by the policy are reported separately from untrusted keys
+		var policyErr *KeyPolicyError
+		if errors.As(err, &policyErr) {
+			rule.addPolicyError(policyErr)
+
+			return true
+		}
+
+		// Certificate errors carry their own error code and context
+		var certErr *CertificateError
+		if errors.As(err, &certErr) {
+			rule.addError(certErr.Code, certErr.Message, certErr.Context)
+
+			return true
+		}
+
 		// Determine error type and add appropriate validation error
 		if strings.Contains(err.Error(), "not verified with any trusted key") {
 			rule.addError(
@@ -330,9 +515,9 @@ func VerifySignatureIdentity(commit *object.Commit, signature string, keyDir str
 	// Verify based on signature type
 	switch sigType {
 	case GPG:
-		identity, err := verifyGPGSignature(commitBytes, signature, sanitizedKeyDir)
+		identity, err := verifyGPGSignature(commitBytes, signature, sanitizedKeyDir, rule.Policy)
 		if handleVerificationError(err, GPG) {
-			return rule
+			return
 		}
 
 		rule.Identity = identity
@@ -350,12 +535,28 @@ func VerifySignatureIdentity(commit *object.Commit, signature string, keyDir str
 				},
 			)
 
-			return rule
+			return
 		}
 
-		identity, err := verifySSHSignature(commitBytes, format, blob, sanitizedKeyDir)
+		identity, err := verifySSHSignature(commitBytes, format, blob, sanitizedKeyDir, rule.Policy)
 		if handleVerificationError(err, SSH) {
-			return rule
+			return
+		}
+
+		rule.Identity = identity
+
+	case X509:
+		identity, err := verifyX509Signature(signerEmail, commitBytes, signature, rule.CABundle, rule.Policy)
+		if handleVerificationError(err, X509) {
+			return
+		}
+
+		rule.Identity = identity
+
+	case Sigstore:
+		identity, err := verifySigstoreSignature(commitBytes, signature, rule.Sigstore, rule.Policy)
+		if handleVerificationError(err, Sigstore) {
+			return
 		}
 
 		rule.Identity = identity
@@ -369,12 +570,41 @@ func VerifySignatureIdentity(commit *object.Commit, signature string, keyDir str
 			},
 		)
 	}
+}
 
-	return rule
+// addPolicyError adds a validation error for a key rejected by the key policy.
+func (s *SignedIdentity) addPolicyError(policyErr *KeyPolicyError) {
+	context := map[string]string{
+		"signature_type": policyErr.KeyType,
+		"key_type":       policyErr.KeyType,
+		"algorithm":      policyErr.Algorithm,
+	}
+
+	switch policyErr.Reason {
+	case PolicyReasonWeakKey:
+		context["key_bits"] = strconv.Itoa(policyErr.Bits)
+		context["required_bits"] = strconv.Itoa(policyErr.RequiredBits)
+	case PolicyReasonDisallowedAlgorithm:
+		context["allowed"] = strings.Join(policyErr.Allowed, ", ")
+	case PolicyReasonDisallowedHash:
+		context["hash_algorithm"] = policyErr.Hash
+		context["allowed"] = strings.Join(policyErr.Allowed, ", ")
+	}
+
+	s.addError(policyErr.Reason, policyErr.Error(), context)
 }
 
-// detectSignatureType determines whether a signature is GPG or SSH based on its format.
+// detectSignatureType determines whether a signature is GPG, SSH, X.509 or Sigstore based on its format.
 func detectSignatureType(signature string) string {
+	// Check for CMS signature format, written by both gpgsm and gitsign
+	if strings.Contains(signature, "-----BEGIN SIGNED MESSAGE-----") {
+		if isSigstoreSignature(signature) {
+			return Sigstore
+		}
+
+		return X509
+	}
+
 	// Check for SSH signature format (format:blob)
 	if strings.Contains(signature, ":") && strings.HasPrefix(signature, "ssh-") {
 		return SSH
@@ -400,6 +630,9 @@ func detectSignatureType(signature string) string {
 // The following functions are assumed to be defined elsewhere in the package:
 // sanitizePath(path string) (string, error)
 // getCommitBytes(commit *object.Commit) ([]byte, error)
+// getTagBytes(tag *object.Tag) ([]byte, error)
 // parseSSHSignature(signature string) (string, []byte, error)
-// verifyGPGSignature(commitData []byte, signature string, keyDir string) (string, error)
-// verifySSHSignature(commit []byte, format string, blob []byte, keyDir string) (string, error)
+// verifyGPGSignature(commitData []byte, signature string, keyDir string, policy KeyPolicy) (string, error)
+// verifySSHSignature(commit []byte, format string, blob []byte, keyDir string, policy KeyPolicy) (string, error)
+// verifyX509Signature(signerEmail string, commitData []byte, signature string, caBundle string, policy KeyPolicy) (string, error)
+// verifySigstoreSignature(commitData []byte, signature string, config *SigstoreConfig, policy KeyPolicy) (string, error)
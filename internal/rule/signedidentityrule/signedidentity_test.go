@@ -229,6 +229,7 @@ type setupRepoOptions struct {
 	authorEmail string
 	message     string
 	signKey     *openpgp.Entity
+	signer      git.Signer
 }
 
 func setupTestRepo(t *testing.T, opts setupRepoOptions) (*git.Repository, *object.Commit) {
@@ -262,6 +263,10 @@ func setupTestRepo(t *testing.T, opts setupRepoOptions) (*git.Repository, *objec
 		commitOpts.SignKey = opts.signKey
 	}
 
+	if opts.signer != nil {
+		commitOpts.Signer = opts.signer
+	}
+
 	hash, err := wtree.Commit(opts.message, commitOpts)
 	require.NoError(t, err)
 
@@ -468,3 +473,109 @@ func TestSignedIdentity_Help(t *testing.T) {
 	ruleNoErrors := SignedIdentity{}
 	assert.Equal(t, "No errors to fix", ruleNoErrors.Help())
 }
+
+// setupTestTag creates an annotated tag on a new commit, signed with signKey when it is set.
+func setupTestTag(t *testing.T, taggerEmail string, signKey *openpgp.Entity) *object.Tag {
+	t.Helper()
+
+	repo, commit := setupTestRepo(t, setupRepoOptions{
+		authorName:  "Test User",
+		authorEmail: taggerEmail,
+		message:     "Tagged commit",
+	})
+
+	ref, err := repo.CreateTag("v1.0.0", commit.Hash, &git.CreateTagOptions{
+		Tagger:  &object.Signature{Name: "Test User", Email: taggerEmail, When: time.Now()},
+		Message: "Release v1.0.0",
+		SignKey: signKey,
+	})
+	require.NoError(t, err)
+
+	tag, err := repo.TagObject(ref.Hash())
+	require.NoError(t, err)
+
+	return tag
+}
+
+func TestVerifyTagSignatureIdentity(t *testing.T) {
+	testDataDir, err := filepath.Abs("testdata")
+	require.NoError(t, err)
+
+	caBundle, err := filepath.Abs(filepath.Join("testdata", "x509-ca.pem"))
+	require.NoError(t, err)
+
+	gpgTag := setupTestTag(t, "test@example.com", loadTestKey(t))
+	require.NotEmpty(t, gpgTag.PGPSignature)
+
+	tamperedTag := setupTestTag(t, "test@example.com", loadTestKey(t))
+	tamperedTag.Message = "Release v6.6.6\n"
+
+	// Sign a tag with the X.509 test signer, as gpgsm would for gpg.format=x509
+	x509Tag := setupTestTag(t, "other@example.com", nil)
+	encoded, err := getTagBytes(x509Tag)
+	require.NoError(t, err)
+
+	x509Signature, err := loadX509TestSigner(t).Sign(bytes.NewReader(encoded))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		tag       *object.Tag
+		signature string
+		opts      []Option
+		wantCode  string
+		wantID    string
+		wantType  string
+	}{
+		{
+			name:      "valid GPG signed tag",
+			tag:       gpgTag,
+			signature: gpgTag.PGPSignature,
+			wantID:    "Test User <test@example.com>",
+			wantType:  GPG,
+		},
+		{
+			name:      "tag modified after signing",
+			tag:       tamperedTag,
+			signature: tamperedTag.PGPSignature,
+			wantCode:  "key_not_trusted",
+		},
+		{
+			name:      "unsigned tag",
+			tag:       x509Tag,
+			signature: "",
+			wantCode:  "no_signature",
+		},
+		{
+			name:      "nil tag",
+			tag:       nil,
+			signature: gpgTag.PGPSignature,
+			wantCode:  "tag_nil",
+		},
+		{
+			name:      "X.509 certificate must match the tagger email",
+			tag:       x509Tag,
+			signature: string(x509Signature),
+			opts:      []Option{WithCABundle(caBundle)},
+			wantCode:  "email_mismatch",
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			result := VerifyTagSignatureIdentity(tabletest.tag, tabletest.signature, testDataDir, tabletest.opts...)
+
+			if tabletest.wantCode != "" {
+				require.NotEmpty(t, result.Errors())
+				assert.Equal(t, tabletest.wantCode, result.Errors()[0].Code)
+				assert.NotEqual(t, "No errors to fix", result.Help())
+
+				return
+			}
+
+			require.Empty(t, result.Errors(), "unexpected errors: %v", result.Errors())
+			assert.Equal(t, tabletest.wantID, result.Identity)
+			assert.Equal(t, tabletest.wantType, result.SignatureType)
+		})
+	}
+}
@@ -0,0 +1,583 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package signedidentityrule
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/github/smimesign/ietf-cms/protocol"
+	rekorpb "github.com/sigstore/protobuf-specs/gen/pb-go/rekor/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	// oidRekorTransparencyLogEntry is the unsigned CMS attribute in which gitsign
+	// embeds a serialized Rekor TransparencyLogEntry.
+	oidRekorTransparencyLogEntry = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 3, 1}
+
+	// oidFulcioIssuer is the Fulcio certificate extension holding the raw OIDC issuer (deprecated).
+	oidFulcioIssuer = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 1}
+
+	// oidFulcioIssuerV2 is the Fulcio certificate extension holding the DER encoded OIDC issuer.
+	oidFulcioIssuerV2 = asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 57264, 1, 8}
+)
+
+// SigstoreConfig configures offline verification of Sigstore (gitsign) keyless signatures.
+//
+// Example:
+//
+//	config := SigstoreConfig{
+//	    FulcioRoots:     "/etc/sigstore/fulcio.pem",
+//	    RekorPublicKeys: "/etc/sigstore/rekor.pub",
+//	    Identities: []SigstoreIdentity{
+//	        {Subject: "jane@example.com", Issuer: "https://github.com/login/oauth"},
+//	    },
+//	}
+type SigstoreConfig struct {
+	// FulcioRoots is a PEM file with the trusted Fulcio root and intermediate certificates.
+	FulcioRoots string
+
+	// RekorPublicKeys is a PEM file with the trusted Rekor transparency log public keys.
+	RekorPublicKeys string
+
+	// Identities lists the OIDC identities allowed to sign commits.
+	Identities []SigstoreIdentity
+}
+
+// SigstoreIdentity is an allowed OIDC identity of a Fulcio certificate.
+type SigstoreIdentity struct {
+	// Subject is the certificate subject: an email address or a URI for workload identities.
+	Subject string
+
+	// Issuer is the OIDC issuer URL. An empty issuer matches any issuer.
+	Issuer string
+}
+
+// verifySigstoreSignature verifies a gitsign keyless signature without network access.
+//
+// Parameters:
+//   - commitData: The raw commit data to verify
+//   - signature: The PEM encoded "SIGNED MESSAGE" block written by gitsign
+//   - config: The trusted root and allowed identities
+//   - policy: The key and hash algorithm policy the signing certificate must satisfy
+//
+// The function performs the following checks:
+//  1. The signature embeds a Rekor transparency log entry
+//  2. The log entry records this exact signature and certificate, and its signed
+//     entry timestamp verifies with a trusted Rekor key
+//  3. An inclusion proof, when embedded, verifies against its signed checkpoint
+//  4. The signature over the commit data is valid and the Fulcio certificate chains
+//     to a trusted root at the time the entry was integrated into the log
+//  5. The signing key and digest algorithm satisfy the key policy
+//  6. The certificate subject and issuer are in the identity allowlist
+//
+// Returns:
+//   - string: The identity of the signer ("subject (issuer)")
+//   - error: A *CertificateError or *KeyPolicyError describing the failure, if any
+func verifySigstoreSignature(commitData []byte, signature string, config *SigstoreConfig, policy KeyPolicy) (string, error) {
+	if config == nil || config.FulcioRoots == "" || config.RekorPublicKeys == "" {
+		return "", newSigstoreError("no_sigstore_root", "no Sigstore trusted root (Fulcio roots and Rekor public keys) provided")
+	}
+
+	roots, err := loadCABundle(config.FulcioRoots)
+	if err != nil {
+		sigErr := newSigstoreError("invalid_sigstore_root", fmt.Sprintf("invalid Fulcio roots: %s", err))
+		sigErr.Context["error"] = err.Error()
+
+		return "", sigErr
+	}
+
+	rekorKeys, err := loadRekorPublicKeys(config.RekorPublicKeys)
+	if err != nil {
+		sigErr := newSigstoreError("invalid_sigstore_root", fmt.Sprintf("invalid Rekor public keys: %s", err))
+		sigErr.Context["error"] = err.Error()
+
+		return "", sigErr
+	}
+
+	der, err := decodeX509Signature(signature)
+	if err != nil {
+		var certErr *CertificateError
+		if errors.As(err, &certErr) {
+			certErr.Context["signature_type"] = Sigstore
+		}
+
+		return "", err
+	}
+
+	signedData, signerInfo, err := parseCMSSignature(der)
+	if err != nil {
+		sigErr := newSigstoreError("invalid_signature_format", fmt.Sprintf("invalid Sigstore signature format: %s", err))
+		sigErr.Context["error"] = err.Error()
+
+		return "", sigErr
+	}
+
+	certs, err := signedData.GetCertificates()
+	if err != nil {
+		sigErr := newSigstoreError("invalid_signature_format", fmt.Sprintf("invalid Sigstore signature format: %s", err))
+		sigErr.Context["error"] = err.Error()
+
+		return "", sigErr
+	}
+
+	cert, err := signerInfo.FindCertificate(certs)
+	if err != nil {
+		sigErr := newSigstoreError("invalid_signature_format", fmt.Sprintf("signing certificate not found: %s", err))
+		sigErr.Context["error"] = err.Error()
+
+		return "", sigErr
+	}
+
+	integratedTime, err := verifyRekorEntry(signerInfo, cert, rekorKeys)
+	if err != nil {
+		return "", err
+	}
+
+	// The Fulcio certificate is short-lived, so it must be valid when the entry was logged
+	chains, err := signedData.VerifyDetached(commitData, x509.VerifyOptions{
+		Roots:       roots,
+		CurrentTime: integratedTime,
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+	})
+	if err != nil {
+		certErr := classifyX509Error(err)
+		certErr.Context["signature_type"] = Sigstore
+		certErr.Context["integrated_time"] = integratedTime.UTC().Format(time.RFC3339)
+
+		return "", certErr
+	}
+
+	if len(chains) == 0 || len(chains[0]) == 0 || len(chains[0][0]) == 0 {
+		return "", newSigstoreError("verification_failed", "Sigstore signature verification returned no certificate chain")
+	}
+
+	leaf := chains[0][0][0]
+
+	if err := checkX509KeyPolicy(leaf, signerInfo, policy); err != nil {
+		var policyErr *KeyPolicyError
+		if errors.As(err, &policyErr) {
+			policyErr.KeyType = Sigstore
+		}
+
+		return "", err
+	}
+
+	subject, issuer := fulcioIdentity(leaf)
+
+	if !sigstoreIdentityAllowed(config.Identities, subject, issuer) {
+		sigErr := newSigstoreError("identity_not_allowed",
+			fmt.Sprintf("Sigstore identity %s (issuer %s) is not in the allowed identities", subject, issuer))
+		sigErr.Context["subject"] = subject
+		sigErr.Context["issuer"] = issuer
+
+		return "", sigErr
+	}
+
+	return fmt.Sprintf("%s (%s)", subject, issuer), nil
+}
+
+// newSigstoreError creates a CertificateError for a Sigstore signature.
+func newSigstoreError(code, message string) *CertificateError {
+	certErr := newCertificateError(code, message)
+	certErr.Context["signature_type"] = Sigstore
+
+	return certErr
+}
+
+// isSigstoreSignature reports whether a CMS signature was created by gitsign, either
+// because it embeds a Rekor log entry or because it was made with a Fulcio certificate.
+func isSigstoreSignature(signature string) bool {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(signature)))
+	if block == nil || block.Type != x509SignaturePEMType {
+		return false
+	}
+
+	signedData, signerInfo, err := parseCMSSignature(block.Bytes)
+	if err != nil {
+		return false
+	}
+
+	if _, err := signerInfo.UnsignedAttrs.GetOnlyAttributeValueBytes(oidRekorTransparencyLogEntry); err == nil {
+		return true
+	}
+
+	certs, err := signedData.GetCertificates()
+	if err != nil {
+		return false
+	}
+
+	for _, cert := range certs {
+		if _, issuer := fulcioIdentity(cert); issuer != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// loadRekorPublicKeys reads the trusted Rekor public keys from a PEM file, indexed by log ID.
+// The log ID of a Rekor instance is the hex encoded SHA-256 of its DER encoded public key.
+func loadRekorPublicKeys(path string) (map[string]crypto.PublicKey, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path: %w", err)
+	}
+
+	fileInfo, err := os.Stat(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("path error: %w", err)
+	}
+
+	if !fileInfo.Mode().IsRegular() {
+		return nil, fmt.Errorf("path is not a regular file: %s", absPath)
+	}
+
+	data, err := safeReadFile(absPath)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]crypto.PublicKey)
+
+	for {
+		var block *pem.Block
+
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+
+		if block.Type != "PUBLIC KEY" {
+			continue
+		}
+
+		key, err := x509.ParsePKIXPublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("invalid public key: %w", err)
+		}
+
+		logID := sha256.Sum256(block.Bytes)
+		keys[hex.EncodeToString(logID[:])] = key
+	}
+
+	if len(keys) == 0 {
+		return nil, fmt.Errorf("no PEM public keys found in %s", path)
+	}
+
+	return keys, nil
+}
+
+// verifyRekorEntry verifies the Rekor log entry embedded in a gitsign signature and
+// returns the time at which the entry was integrated into the log.
+func verifyRekorEntry(signerInfo protocol.SignerInfo, cert *x509.Certificate, rekorKeys map[string]crypto.PublicKey) (time.Time, error) {
+	entry, err := parseRekorEntry(signerInfo)
+	if err != nil {
+		sigErr := newSigstoreError("missing_tlog_entry", fmt.Sprintf("no Rekor transparency log entry in signature: %s", err))
+		sigErr.Context["error"] = err.Error()
+
+		return time.Time{}, sigErr
+	}
+
+	logID := hex.EncodeToString(entry.GetLogId().GetKeyId())
+	rekorKey, found := rekorKeys[logID]
+
+	if !found {
+		sigErr := newSigstoreError("invalid_tlog_entry", "Rekor log entry was not created by a trusted transparency log")
+		sigErr.Context["log_id"] = logID
+
+		return time.Time{}, sigErr
+	}
+
+	// Rebuild the hashedrekord body gitsign uploaded, which binds the entry to this signature
+	body, err := hashedRekordBody(signerInfo, cert)
+	if err != nil {
+		sigErr := newSigstoreError("invalid_tlog_entry", fmt.Sprintf("cannot reconstruct Rekor entry: %s", err))
+		sigErr.Context["error"] = err.Error()
+
+		return time.Time{}, sigErr
+	}
+
+	if err := verifySignedEntryTimestamp(entry, body, logID, rekorKey); err != nil {
+		sigErr := newSigstoreError("invalid_tlog_entry", fmt.Sprintf("invalid Rekor log entry: %s", err))
+		sigErr.Context["error"] = err.Error()
+		sigErr.Context["log_index"] = strconv.FormatInt(entry.GetLogIndex(), 10)
+
+		return time.Time{}, sigErr
+	}
+
+	if proof := entry.GetInclusionProof(); proof != nil {
+		if err := verifyInclusionProof(proof, body, rekorKey); err != nil {
+			sigErr := newSigstoreError("invalid_tlog_entry", fmt.Sprintf("invalid Rekor inclusion proof: %s", err))
+			sigErr.Context["error"] = err.Error()
+			sigErr.Context["log_index"] = strconv.FormatInt(entry.GetLogIndex(), 10)
+
+			return time.Time{}, sigErr
+		}
+	}
+
+	return time.Unix(entry.GetIntegratedTime(), 0), nil
+}
+
+// parseRekorEntry extracts the serialized TransparencyLogEntry from the unsigned attributes.
+func parseRekorEntry(signerInfo protocol.SignerInfo) (*rekorpb.TransparencyLogEntry, error) {
+	value, err := signerInfo.UnsignedAttrs.GetOnlyAttributeValueBytes(oidRekorTransparencyLogEntry)
+	if err != nil {
+		return nil, err
+	}
+
+	var serialized []byte
+	if _, err := asn1.Unmarshal(value.FullBytes, &serialized); err != nil {
+		return nil, fmt.Errorf("invalid log entry attribute: %w", err)
+	}
+
+	entry := &rekorpb.TransparencyLogEntry{}
+	if err := proto.Unmarshal(serialized, entry); err != nil {
+		return nil, fmt.Errorf("invalid log entry: %w", err)
+	}
+
+	if entry.GetInclusionPromise() == nil {
+		return nil, errors.New("log entry has no signed entry timestamp")
+	}
+
+	return entry, nil
+}
+
+// hashedRekordBody returns the canonical hashedrekord v0.0.1 entry for a CMS signer,
+// as recorded in Rekor by gitsign.
+func hashedRekordBody(signerInfo protocol.SignerInfo, cert *x509.Certificate) ([]byte, error) {
+	message, err := signerInfo.SignedAttrs.MarshaledForVerification()
+	if err != nil {
+		return nil, err
+	}
+
+	digest := sha256.Sum256(message)
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})
+
+	// encoding/json sorts map keys and adds no whitespace, which matches the
+	// canonical JSON form for this content (base64 and hex need no escaping)
+	return json.Marshal(map[string]any{
+		"apiVersion": "0.0.1",
+		"kind":       "hashedrekord",
+		"spec": map[string]any{
+			"data": map[string]any{
+				"hash": map[string]any{
+					"algorithm": "sha256",
+					"value":     hex.EncodeToString(digest[:]),
+				},
+			},
+			"signature": map[string]any{
+				"content": base64.StdEncoding.EncodeToString(signerInfo.Signature),
+				"publicKey": map[string]any{
+					"content": base64.StdEncoding.EncodeToString(certPEM),
+				},
+			},
+		},
+	})
+}
+
+// verifySignedEntryTimestamp verifies the Rekor promise that the entry was logged at its integrated time.
+func verifySignedEntryTimestamp(entry *rekorpb.TransparencyLogEntry, body []byte, logID string, key crypto.PublicKey) error {
+	payload, err := json.Marshal(map[string]any{
+		"body":           base64.StdEncoding.EncodeToString(body),
+		"integratedTime": entry.GetIntegratedTime(),
+		"logID":          logID,
+		"logIndex":       entry.GetLogIndex(),
+	})
+	if err != nil {
+		return err
+	}
+
+	if !verifyWithPublicKey(key, payload, entry.GetInclusionPromise().GetSignedEntryTimestamp()) {
+		return errors.New("signed entry timestamp does not verify with the Rekor public key")
+	}
+
+	return nil
+}
+
+// verifyInclusionProof verifies an RFC 6962 inclusion proof and its signed checkpoint.
+func verifyInclusionProof(proof *rekorpb.InclusionProof, body []byte, key crypto.PublicKey) error {
+	leafHash := sha256.Sum256(append([]byte{0}, body...))
+
+	root, err := rootFromInclusionProof(proof.GetLogIndex(), proof.GetTreeSize(), leafHash[:], proof.GetHashes())
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(root, proof.GetRootHash()) {
+		return errors.New("inclusion proof does not match the root hash")
+	}
+
+	checkpoint := proof.GetCheckpoint().GetEnvelope()
+	if checkpoint == "" {
+		return nil
+	}
+
+	return verifyCheckpoint(checkpoint, proof.GetTreeSize(), proof.GetRootHash(), key)
+}
+
+// rootFromInclusionProof computes the Merkle tree root for a leaf and its audit path
+// (RFC 9162, section 2.1.3.2).
+func rootFromInclusionProof(index, size int64, leafHash []byte, proof [][]byte) ([]byte, error) {
+	if index < 0 || index >= size {
+		return nil, fmt.Errorf("log index %d out of range for tree size %d", index, size)
+	}
+
+	fn, sn := index, size-1
+	result := leafHash
+
+	for _, sibling := range proof {
+		if sn == 0 {
+			return nil, errors.New("inclusion proof is too long")
+		}
+
+		if fn&1 == 1 || fn == sn {
+			result = hashMerkleChildren(sibling, result)
+
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			result = hashMerkleChildren(result, sibling)
+		}
+
+		fn >>= 1
+		sn >>= 1
+	}
+
+	if sn != 0 {
+		return nil, errors.New("inclusion proof is too short")
+	}
+
+	return result, nil
+}
+
+// hashMerkleChildren returns the RFC 6962 hash of an interior Merkle tree node.
+func hashMerkleChildren(left, right []byte) []byte {
+	hash := sha256.New()
+	hash.Write([]byte{1})
+	hash.Write(left)
+	hash.Write(right)
+
+	return hash.Sum(nil)
+}
+
+// verifyCheckpoint verifies a signed note checkpoint and that it commits to the given tree.
+func verifyCheckpoint(envelope string, treeSize int64, rootHash []byte, key crypto.PublicKey) error {
+	text, signatures, found := strings.Cut(envelope, "\n\n")
+	if !found {
+		return errors.New("malformed checkpoint")
+	}
+
+	text += "\n"
+
+	lines := strings.Split(text, "\n")
+	if len(lines) < 3 {
+		return errors.New("malformed checkpoint")
+	}
+
+	if lines[1] != strconv.FormatInt(treeSize, 10) || lines[2] != base64.StdEncoding.EncodeToString(rootHash) {
+		return errors.New("checkpoint does not match the inclusion proof")
+	}
+
+	for _, line := range strings.Split(signatures, "\n") {
+		// Signature lines are "— <name> <base64(key hint || signature)>"
+		fields := strings.Fields(strings.TrimPrefix(line, "— "))
+		if len(fields) != 2 {
+			continue
+		}
+
+		raw, err := base64.StdEncoding.DecodeString(fields[1])
+		if err != nil || len(raw) <= 4 {
+			continue
+		}
+
+		if verifyWithPublicKey(key, []byte(text), raw[4:]) {
+			return nil
+		}
+	}
+
+	return errors.New("checkpoint signature does not verify with the Rekor public key")
+}
+
+// verifyWithPublicKey verifies a SHA-256 based signature made by a Rekor key.
+func verifyWithPublicKey(key crypto.PublicKey, message, signature []byte) bool {
+	digest := sha256.Sum256(message)
+
+	switch publicKey := key.(type) {
+	case *ecdsa.PublicKey:
+		return ecdsa.VerifyASN1(publicKey, digest[:], signature)
+	case *rsa.PublicKey:
+		return rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, digest[:], signature) == nil
+	case ed25519.PublicKey:
+		return ed25519.Verify(publicKey, message, signature)
+	default:
+		return false
+	}
+}
+
+// fulcioIdentity returns the OIDC subject and issuer recorded in a Fulcio certificate.
+// The issuer is empty for certificates not issued by Fulcio.
+func fulcioIdentity(cert *x509.Certificate) (string, string) {
+	var subject string
+
+	switch {
+	case len(cert.EmailAddresses) > 0:
+		subject = cert.EmailAddresses[0]
+	case len(cert.URIs) > 0:
+		subject = cert.URIs[0].String()
+	}
+
+	var issuer string
+
+	for _, ext := range cert.Extensions {
+		switch {
+		case ext.Id.Equal(oidFulcioIssuerV2):
+			var value string
+			if _, err := asn1.Unmarshal(ext.Value, &value); err == nil {
+				return subject, value
+			}
+		case ext.Id.Equal(oidFulcioIssuer):
+			issuer = string(ext.Value)
+		}
+	}
+
+	return subject, issuer
+}
+
+// sigstoreIdentityAllowed reports whether subject and issuer match an allowed identity.
+// Subjects are compared case-insensitively, issuers exactly.
+func sigstoreIdentityAllowed(identities []SigstoreIdentity, subject, issuer string) bool {
+	for _, identity := range identities {
+		if !strings.EqualFold(identity.Subject, subject) {
+			continue
+		}
+
+		if identity.Issuer == "" || identity.Issuer == issuer {
+			return true
+		}
+	}
+
+	return false
+}
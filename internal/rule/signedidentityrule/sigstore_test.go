@@ -0,0 +1,414 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+package signedidentityrule
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	cms "github.com/github/smimesign/ietf-cms"
+	"github.com/github/smimesign/ietf-cms/protocol"
+	commonpb "github.com/sigstore/protobuf-specs/gen/pb-go/common/v1"
+	rekorpb "github.com/sigstore/protobuf-specs/gen/pb-go/rekor/v1"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	testSigstoreSubject = "jane@example.com"
+	testSigstoreIssuer  = "https://issuer.example.com"
+)
+
+// sigstoreTestRoot is a fake Fulcio CA and Rekor log used to produce gitsign style signatures.
+type sigstoreTestRoot struct {
+	caCert    *x509.Certificate
+	caKey     *ecdsa.PrivateKey
+	rekorKey  *ecdsa.PrivateKey
+	rekorDER  []byte
+	rootsPath string
+	rekorPath string
+}
+
+func newSigstoreTestRoot(t *testing.T) sigstoreTestRoot {
+	t.Helper()
+
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test Fulcio"},
+		NotBefore:             time.Now().Add(-24 * time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	caDER, err := x509.CreateCertificate(rand.Reader, template, template, &caKey.PublicKey, caKey)
+	require.NoError(t, err)
+
+	caCert, err := x509.ParseCertificate(caDER)
+	require.NoError(t, err)
+
+	rekorKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	rekorDER, err := x509.MarshalPKIXPublicKey(&rekorKey.PublicKey)
+	require.NoError(t, err)
+
+	dir := t.TempDir()
+	rootsPath := filepath.Join(dir, "fulcio.pem")
+	rekorPath := filepath.Join(dir, "rekor.pub")
+
+	require.NoError(t, os.WriteFile(rootsPath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}), 0600))
+	require.NoError(t, os.WriteFile(rekorPath, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: rekorDER}), 0600))
+
+	return sigstoreTestRoot{
+		caCert:    caCert,
+		caKey:     caKey,
+		rekorKey:  rekorKey,
+		rekorDER:  rekorDER,
+		rootsPath: rootsPath,
+		rekorPath: rekorPath,
+	}
+}
+
+// gitsignTestSigner signs commits the way gitsign does: a CMS signature made with a
+// short-lived Fulcio certificate, with the Rekor log entry as an unsigned attribute.
+type gitsignTestSigner struct {
+	root           sigstoreTestRoot
+	signedAt       time.Time // Time the Fulcio certificate was issued and the entry logged
+	integratedTime time.Time // Time recorded in the log entry, defaults to signedAt
+	omitLogEntry   bool
+	breakSET       bool
+	breakProof     bool
+}
+
+func (s gitsignTestSigner) Sign(message io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(message)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	issuer, err := asn1.MarshalWithParams(testSigstoreIssuer, "utf8")
+	if err != nil {
+		return nil, err
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:    big.NewInt(time.Now().UnixNano()),
+		EmailAddresses:  []string{testSigstoreSubject},
+		NotBefore:       s.signedAt.Add(-time.Minute),
+		NotAfter:        s.signedAt.Add(10 * time.Minute),
+		KeyUsage:        x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:     []x509.ExtKeyUsage{x509.ExtKeyUsageCodeSigning},
+		ExtraExtensions: []pkix.Extension{{Id: oidFulcioIssuerV2, Value: issuer}},
+	}
+
+	certDER, err := x509.CreateCertificate(rand.Reader, template, s.root.caCert, &key.PublicKey, s.root.caKey)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		return nil, err
+	}
+
+	der, err := cms.SignDetached(data, []*x509.Certificate{cert}, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !s.omitLogEntry {
+		der, err = s.addLogEntry(der, cert)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: x509SignaturePEMType, Bytes: der}), nil
+}
+
+// addLogEntry adds a signed Rekor log entry with an inclusion proof to a CMS signature.
+func (s gitsignTestSigner) addLogEntry(der []byte, cert *x509.Certificate) ([]byte, error) {
+	contentInfo, err := protocol.ParseContentInfo(der)
+	if err != nil {
+		return nil, err
+	}
+
+	signedData, err := contentInfo.SignedDataContent()
+	if err != nil {
+		return nil, err
+	}
+
+	signerInfo := signedData.SignerInfos[0]
+
+	body, err := hashedRekordBody(signerInfo, cert)
+	if err != nil {
+		return nil, err
+	}
+
+	integratedTime := s.integratedTime
+	if integratedTime.IsZero() {
+		integratedTime = s.signedAt
+	}
+
+	logID := sha256.Sum256(s.root.rekorDER)
+	entry := &rekorpb.TransparencyLogEntry{
+		LogIndex:       1,
+		LogId:          &commonpb.LogId{KeyId: logID[:]},
+		KindVersion:    &rekorpb.KindVersion{Kind: "hashedrekord", Version: "0.0.1"},
+		IntegratedTime: integratedTime.Unix(),
+	}
+
+	// Signed entry timestamp over the canonical payload
+	payload := `{"body":"` + base64.StdEncoding.EncodeToString(body) +
+		`","integratedTime":` + strconv.FormatInt(integratedTime.Unix(), 10) +
+		`,"logID":"` + hexString(logID[:]) + `","logIndex":1}`
+
+	setSignature, err := s.rekorSign([]byte(payload))
+	if err != nil {
+		return nil, err
+	}
+
+	if s.breakSET {
+		setSignature[len(setSignature)-1] ^= 0xff
+	}
+
+	entry.InclusionPromise = &rekorpb.InclusionPromise{SignedEntryTimestamp: setSignature}
+
+	// Tree of two leaves, with the entry as the second leaf
+	leafHash := sha256.Sum256(append([]byte{0}, body...))
+	sibling := sha256.Sum256([]byte{0, 'x'})
+	root := hashMerkleChildren(sibling[:], leafHash[:])
+
+	if s.breakProof {
+		root[0] ^= 0xff
+	}
+
+	note := "rekor.test - 1\n2\n" + base64.StdEncoding.EncodeToString(root) + "\n"
+
+	noteSignature, err := s.rekorSign([]byte(note))
+	if err != nil {
+		return nil, err
+	}
+
+	entry.InclusionProof = &rekorpb.InclusionProof{
+		LogIndex: 1,
+		RootHash: root,
+		TreeSize: 2,
+		Hashes:   [][]byte{sibling[:]},
+		Checkpoint: &rekorpb.Checkpoint{
+			Envelope: note + "\n— rekor.test " + base64.StdEncoding.EncodeToString(append([]byte{1, 2, 3, 4}, noteSignature...)) + "\n",
+		},
+	}
+
+	serialized, err := proto.Marshal(entry)
+	if err != nil {
+		return nil, err
+	}
+
+	attribute, err := protocol.NewAttribute(oidRekorTransparencyLogEntry, serialized)
+	if err != nil {
+		return nil, err
+	}
+
+	signedData.SignerInfos[0].UnsignedAttrs = append(signedData.SignerInfos[0].UnsignedAttrs, attribute)
+
+	return signedData.ContentInfoDER()
+}
+
+func (s gitsignTestSigner) rekorSign(message []byte) ([]byte, error) {
+	digest := sha256.Sum256(message)
+
+	return ecdsa.SignASN1(rand.Reader, s.root.rekorKey, digest[:])
+}
+
+func hexString(data []byte) string {
+	const digits = "0123456789abcdef"
+
+	out := make([]byte, 0, len(data)*2)
+	for _, b := range data {
+		out = append(out, digits[b>>4], digits[b&0x0f])
+	}
+
+	return string(out)
+}
+
+func TestVerifySigstoreSignature(t *testing.T) {
+	root := newSigstoreTestRoot(t)
+	otherRoot := newSigstoreTestRoot(t)
+
+	// The Fulcio certificate expired long before verification, as in real gitsign usage
+	signedAt := time.Now().Add(-2 * time.Hour)
+
+	allowed := []SigstoreIdentity{{Subject: testSigstoreSubject, Issuer: testSigstoreIssuer}}
+
+	tests := []struct {
+		name     string
+		signer   gitsignTestSigner
+		config   *SigstoreConfig
+		wantCode string
+		wantID   string
+	}{
+		{
+			name:   "valid gitsign signature with expired certificate",
+			signer: gitsignTestSigner{root: root, signedAt: signedAt},
+			config: &SigstoreConfig{FulcioRoots: root.rootsPath, RekorPublicKeys: root.rekorPath, Identities: allowed},
+			wantID: testSigstoreSubject + " (" + testSigstoreIssuer + ")",
+		},
+		{
+			name:   "identity allowed for any issuer",
+			signer: gitsignTestSigner{root: root, signedAt: signedAt},
+			config: &SigstoreConfig{
+				FulcioRoots:     root.rootsPath,
+				RekorPublicKeys: root.rekorPath,
+				Identities:      []SigstoreIdentity{{Subject: "Jane@Example.com"}},
+			},
+			wantID: testSigstoreSubject + " (" + testSigstoreIssuer + ")",
+		},
+		{
+			name:     "no trusted root configured",
+			signer:   gitsignTestSigner{root: root, signedAt: signedAt},
+			wantCode: "no_sigstore_root",
+		},
+		{
+			name:   "subject not in allowlist",
+			signer: gitsignTestSigner{root: root, signedAt: signedAt},
+			config: &SigstoreConfig{
+				FulcioRoots:     root.rootsPath,
+				RekorPublicKeys: root.rekorPath,
+				Identities:      []SigstoreIdentity{{Subject: "john@example.com", Issuer: testSigstoreIssuer}},
+			},
+			wantCode: "identity_not_allowed",
+		},
+		{
+			name:   "issuer not in allowlist",
+			signer: gitsignTestSigner{root: root, signedAt: signedAt},
+			config: &SigstoreConfig{
+				FulcioRoots:     root.rootsPath,
+				RekorPublicKeys: root.rekorPath,
+				Identities:      []SigstoreIdentity{{Subject: testSigstoreSubject, Issuer: "https://other.example.com"}},
+			},
+			wantCode: "identity_not_allowed",
+		},
+		{
+			name:     "missing log entry",
+			signer:   gitsignTestSigner{root: root, signedAt: signedAt, omitLogEntry: true},
+			config:   &SigstoreConfig{FulcioRoots: root.rootsPath, RekorPublicKeys: root.rekorPath, Identities: allowed},
+			wantCode: "missing_tlog_entry",
+		},
+		{
+			name:     "log entry from untrusted log",
+			signer:   gitsignTestSigner{root: root, signedAt: signedAt},
+			config:   &SigstoreConfig{FulcioRoots: root.rootsPath, RekorPublicKeys: otherRoot.rekorPath, Identities: allowed},
+			wantCode: "invalid_tlog_entry",
+		},
+		{
+			name:     "tampered signed entry timestamp",
+			signer:   gitsignTestSigner{root: root, signedAt: signedAt, breakSET: true},
+			config:   &SigstoreConfig{FulcioRoots: root.rootsPath, RekorPublicKeys: root.rekorPath, Identities: allowed},
+			wantCode: "invalid_tlog_entry",
+		},
+		{
+			name:     "inclusion proof does not match root hash",
+			signer:   gitsignTestSigner{root: root, signedAt: signedAt, breakProof: true},
+			config:   &SigstoreConfig{FulcioRoots: root.rootsPath, RekorPublicKeys: root.rekorPath, Identities: allowed},
+			wantCode: "invalid_tlog_entry",
+		},
+		{
+			name:     "certificate from untrusted Fulcio",
+			signer:   gitsignTestSigner{root: root, signedAt: signedAt},
+			config:   &SigstoreConfig{FulcioRoots: otherRoot.rootsPath, RekorPublicKeys: root.rekorPath, Identities: allowed},
+			wantCode: "certificate_untrusted",
+		},
+		{
+			name:     "certificate not valid when entry was logged",
+			signer:   gitsignTestSigner{root: root, signedAt: signedAt, integratedTime: signedAt.Add(time.Hour)},
+			config:   &SigstoreConfig{FulcioRoots: root.rootsPath, RekorPublicKeys: root.rekorPath, Identities: allowed},
+			wantCode: "certificate_expired",
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			_, commit := setupTestRepo(t, setupRepoOptions{
+				authorName:  "Jane Doe",
+				authorEmail: testSigstoreSubject,
+				message:     "Signed with gitsign",
+				signer:      tabletest.signer,
+			})
+
+			var opts []Option
+			if tabletest.config != nil {
+				opts = append(opts, WithSigstore(*tabletest.config))
+			}
+
+			result := VerifySignatureIdentity(commit, commit.PGPSignature, "", opts...)
+
+			require.Equal(t, Sigstore, result.SignatureType)
+
+			if tabletest.wantCode == "" {
+				require.Empty(t, result.Errors(), "Expected no errors but got: %v", result.Errors())
+				require.Equal(t, tabletest.wantID, result.Identity)
+
+				return
+			}
+
+			require.Len(t, result.Errors(), 1)
+			require.Equal(t, tabletest.wantCode, result.Errors()[0].Code, result.Errors()[0].Error())
+			require.Equal(t, Sigstore, result.Errors()[0].Context["signature_type"])
+			require.NotEqual(t, "No errors to fix", result.Help())
+		})
+	}
+}
+
+func TestRootFromInclusionProof(t *testing.T) {
+	leaves := make([][]byte, 5)
+	for i := range leaves {
+		hash := sha256.Sum256([]byte{0, byte(i)})
+		leaves[i] = hash[:]
+	}
+
+	// Tree of five leaves: root = H(H(H(0,1), H(2,3)), 4)
+	node01 := hashMerkleChildren(leaves[0], leaves[1])
+	node23 := hashMerkleChildren(leaves[2], leaves[3])
+	node0123 := hashMerkleChildren(node01, node23)
+	root := hashMerkleChildren(node0123, leaves[4])
+
+	got, err := rootFromInclusionProof(2, 5, leaves[2], [][]byte{leaves[3], node01, leaves[4]})
+	require.NoError(t, err)
+	require.Equal(t, root, got)
+
+	got, err = rootFromInclusionProof(4, 5, leaves[4], [][]byte{node0123})
+	require.NoError(t, err)
+	require.Equal(t, root, got)
+
+	_, err = rootFromInclusionProof(4, 5, leaves[4], nil)
+	require.Error(t, err)
+
+	_, err = rootFromInclusionProof(5, 5, leaves[4], [][]byte{node0123})
+	require.Error(t, err)
+}
@@ -57,15 +57,17 @@ func parseSSHSignature(signature string) (string, []byte, error) {
 //   - format: The signature algorithm format (e.g., "ssh-rsa")
 //   - blob: The signature blob data
 //   - keyDir: Directory containing trusted public keys
+//   - policy: The key and hash algorithm policy the signing key must satisfy
 //
 // The function attempts to verify the signature against all trusted SSH keys found
-// in the specified directory. It checks that keys meet minimum strength requirements
-// before attempting verification.
+// in the specified directory. A key that verifies the signature must also satisfy
+// the key policy; otherwise a *KeyPolicyError is returned instead of the generic
+// "not verified" error, so policy rejections are reported separately.
 //
 // Returns:
 //   - string: The identity (name/comment) associated with the key that verified the signature
 //   - error: Any error encountered during verification, or if no key verified the signature
-func verifySSHSignature(commitData []byte, format string, blob []byte, keyDir string) (string, error) {
+func verifySSHSignature(commitData []byte, format string, blob []byte, keyDir string, policy KeyPolicy) (string, error) {
 	if len(blob) == 0 {
 		return "", errors.New("empty SSH signature blob")
 	}
@@ -86,6 +88,8 @@ func verifySSHSignature(commitData []byte, format string, blob []byte, keyDir st
 		return "", fmt.Errorf("no SSH key files found in %s", keyDir)
 	}
 
+	var policyErr error
+
 	// Try each key
 	for _, keyFile := range sshKeyFiles {
 		keyName, pubKey, err := loadSSHKey(keyFile)
@@ -93,15 +97,25 @@ func verifySSHSignature(commitData []byte, format string, blob []byte, keyDir st
 			continue // Skip invalid keys
 		}
 
-		// Check if key meets minimum strength requirements
-		if !sshKeyHasMinimumStrength(pubKey) {
-			continue // Skip weak keys
+		// Verify signature
+		if err := pubKey.Verify(commitData, sshSignature); err != nil {
+			continue
 		}
 
-		// Verify signature
-		if err := pubKey.Verify(commitData, sshSignature); err == nil {
-			return keyName, nil
+		// The key matches, but it must also satisfy the key policy
+		if err := checkSSHKeyPolicy(pubKey, format, policy); err != nil {
+			if policyErr == nil {
+				policyErr = err
+			}
+
+			continue
 		}
+
+		return keyName, nil
+	}
+
+	if policyErr != nil {
+		return "", policyErr
 	}
 
 	return "", errors.New("SSH signature not verified with any trusted key")
@@ -175,53 +189,75 @@ func loadSSHKey(path string) (string, ssh.PublicKey, error) {
 	return keyName, pubKey, nil
 }
 
-// sshKeyHasMinimumStrength checks if an SSH key meets minimum strength requirements.
+// checkSSHKeyPolicy checks an SSH key and signature format against the key policy.
 //
 // Parameters:
-//   - pubKey: The SSH public key to check
+//   - pubKey: The SSH public key that produced the signature
+//   - format: The signature algorithm format (e.g., "rsa-sha2-512")
+//   - policy: The key policy to enforce
 //
 // The function examines the key type and bit length to determine if it meets
-// the configured minimum security standards. Different key types have different
+// the configured security standards. Different key types have different
 // security characteristics:
-//   - RSA keys should have at least MinimumRSABits (default: 2048)
-//   - ECDSA keys should meet curve strength requirements
+//   - RSA keys should have at least policy.MinRSABits (default: 2048)
+//   - ECDSA keys are sized by their curve (256, 384 or 521 bits)
 //   - Ed25519 keys have fixed 256-bit security
 //
+// The signature hash is derived from the format; "ssh-rsa" signatures use SHA-1.
+//
 // Returns:
-//   - bool: true if the key meets minimum strength requirements, false otherwise
-func sshKeyHasMinimumStrength(pubKey ssh.PublicKey) bool {
-	// Get key type
-	keyType := pubKey.Type()
-
-	switch keyType {
-	case "ssh-rsa":
-		// For RSA keys, we need to extract the key data to get the bit length
-		// Try to access the underlying key
-		cryptoPublicKey, ok := pubKey.(ssh.CryptoPublicKey)
-		if !ok {
-			fmt.Println("Invalid crypto key") // To-Do, return errors.
-
-			return false
-		}
+//   - error: A *KeyPolicyError describing the violation, or nil if the key is acceptable
+func checkSSHKeyPolicy(pubKey ssh.PublicKey, format string, policy KeyPolicy) error {
+	algorithm, bits := sshKeyAlgorithm(pubKey)
 
-		cryptoKey := cryptoPublicKey.CryptoPublicKey()
+	if err := policy.checkKey(SSH, algorithm, bits); err != nil {
+		return err
+	}
+
+	return policy.checkHash(SSH, sshSignatureHash(format))
+}
 
-		if rsaKey, ok := cryptoKey.(*rsa.PublicKey); ok {
-			// RSA key bit length is determined by the modulus size
-			return rsaKey.N.BitLen() >= int(MinimumRSABits)
+// sshKeyAlgorithm returns the normalised algorithm name and bit length of an SSH key.
+// The bit length is 0 when it cannot be determined.
+func sshKeyAlgorithm(pubKey ssh.PublicKey) (string, int) {
+	switch pubKey.Type() {
+	case ssh.KeyAlgoRSA:
+		// RSA key bit length is determined by the modulus size
+		if cryptoPublicKey, ok := pubKey.(ssh.CryptoPublicKey); ok {
+			if rsaKey, ok := cryptoPublicKey.CryptoPublicKey().(*rsa.PublicKey); ok {
+				return AlgorithmRSA, rsaKey.N.BitLen()
+			}
 		}
-		// If we can't access the key directly, fallback to false for safety
-		return false
-
-	case "ecdsa-sha2-nistp256":
-		return MinimumECBits <= 256
-	case "ecdsa-sha2-nistp384":
-		return MinimumECBits <= 384
-	case "ecdsa-sha2-nistp521":
-		return MinimumECBits <= 521
-	case "ssh-ed25519":
-		return MinimumECBits <= 256 // Ed25519 is always 256 bits
+
+		return AlgorithmRSA, 0
+	case ssh.KeyAlgoDSA: //nolint:staticcheck
+		return AlgorithmDSA, 1024 // ssh-dss keys are limited to 1024 bits
+	case ssh.KeyAlgoECDSA256, ssh.KeyAlgoSKECDSA256:
+		return AlgorithmECDSA, 256
+	case ssh.KeyAlgoECDSA384:
+		return AlgorithmECDSA, 384
+	case ssh.KeyAlgoECDSA521:
+		return AlgorithmECDSA, 521
+	case ssh.KeyAlgoED25519, ssh.KeyAlgoSKED25519:
+		return AlgorithmEd25519, 256 // Ed25519 is always 256 bits
+	default:
+		return pubKey.Type(), 0
+	}
+}
+
+// sshSignatureHash returns the normalised hash name implied by an SSH signature format.
+// It returns an empty string for formats whose hash is fixed by the key algorithm.
+func sshSignatureHash(format string) string {
+	switch format {
+	case ssh.KeyAlgoRSA, ssh.KeyAlgoDSA: //nolint:staticcheck
+		return "sha1"
+	case ssh.KeyAlgoRSASHA256, ssh.KeyAlgoECDSA256:
+		return "sha256"
+	case ssh.KeyAlgoECDSA384:
+		return "sha384"
+	case ssh.KeyAlgoRSASHA512, ssh.KeyAlgoECDSA521:
+		return "sha512"
 	default:
-		return false
+		return ""
 	}
 }
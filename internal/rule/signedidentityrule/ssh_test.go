@@ -4,9 +4,13 @@
 package signedidentityrule
 
 import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
 )
 
 func TestParseSSHSignature(t *testing.T) {
@@ -55,18 +59,83 @@ func TestParseSSHSignature(t *testing.T) {
 	}
 }
 
-// Add more SSH-specific tests.
-func TestSSHKeyHasMinimumStrength(t *testing.T) {
-	// This would test the SSH key strength validation
-	t.Run("strong enough key", func(t *testing.T) {
-		// Skip test for now since we need proper SSH key mocks
-		// Example of what a real test might look like:
-		// keyData := []byte("ssh-rsa AAAAB3NzaC1yc2EAAAADA...")  // Mock RSA key data
-		// pubKey, err := ssh.ParsePublicKey(keyData)
-		// require.NoError(t, err)
-		// require.True(t, sshKeyHasMinimumStrength(pubKey), "SSH key should meet minimum strength requirements")
-		t.Skip("Requires SSH key mocks with known strengths")
-	})
+func TestCheckSSHKeyPolicy(t *testing.T) {
+	edPublic, _, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	edKey, err := ssh.NewPublicKey(edPublic)
+	require.NoError(t, err)
+
+	rsaPrivate, err := rsa.GenerateKey(rand.Reader, 1024)
+	require.NoError(t, err)
+
+	rsaKey, err := ssh.NewPublicKey(&rsaPrivate.PublicKey)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		key        ssh.PublicKey
+		format     string
+		policy     KeyPolicy
+		wantReason string
+	}{
+		{
+			name:   "ed25519 key with default policy",
+			key:    edKey,
+			format: ssh.KeyAlgoED25519,
+			policy: DefaultKeyPolicy(),
+		},
+		{
+			name:       "RSA 1024 key below default minimum",
+			key:        rsaKey,
+			format:     ssh.KeyAlgoRSASHA512,
+			policy:     DefaultKeyPolicy(),
+			wantReason: PolicyReasonWeakKey,
+		},
+		{
+			name:   "RSA 1024 key with lowered minimum",
+			key:    rsaKey,
+			format: ssh.KeyAlgoRSASHA512,
+			policy: KeyPolicy{MinRSABits: 1024}.withDefaults(),
+		},
+		{
+			name:       "RSA key rejected by ed25519-only policy",
+			key:        rsaKey,
+			format:     ssh.KeyAlgoRSASHA512,
+			policy:     KeyPolicy{MinRSABits: 1024, AllowedAlgorithms: []string{"ed25519"}}.withDefaults(),
+			wantReason: PolicyReasonDisallowedAlgorithm,
+		},
+		{
+			name:       "SHA-1 ssh-rsa signature rejected by hash policy",
+			key:        rsaKey,
+			format:     ssh.KeyAlgoRSA,
+			policy:     KeyPolicy{MinRSABits: 1024, AllowedHashAlgorithms: []string{"sha256", "sha512"}}.withDefaults(),
+			wantReason: PolicyReasonDisallowedHash,
+		},
+		{
+			name:   "ed25519 signature has no separate hash to check",
+			key:    edKey,
+			format: ssh.KeyAlgoED25519,
+			policy: KeyPolicy{AllowedHashAlgorithms: []string{"sha256"}}.withDefaults(),
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			err := checkSSHKeyPolicy(tabletest.key, tabletest.format, tabletest.policy)
+
+			if tabletest.wantReason == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			var policyErr *KeyPolicyError
+			require.ErrorAs(t, err, &policyErr)
+			require.Equal(t, tabletest.wantReason, policyErr.Reason)
+			require.Equal(t, SSH, policyErr.KeyType)
+		})
+	}
 }
 
 func TestVerifySSHSignature(t *testing.T) {
@@ -0,0 +1,45 @@
+#!/bin/bash
+
+# SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+#
+# SPDX-License-Identifier: CC0-1.0
+
+# Generates an offline test CA and an S/MIME signing certificate for the
+# X.509 (gpgsm) signature tests. Run from the signedidentityrule directory.
+
+set -euo pipefail
+
+TESTDATA_DIR="testdata"
+WORK_DIR=$(mktemp -d)
+trap 'rm -rf "$WORK_DIR"' EXIT
+
+echo "Generating X.509 test certificates in $TESTDATA_DIR..."
+
+# Test CA
+openssl ecparam -name prime256v1 -genkey -noout -out "$TESTDATA_DIR/x509-ca.key"
+openssl req -x509 -new -key "$TESTDATA_DIR/x509-ca.key" -sha256 -days 36500 \
+  -subj "/O=Gommitlint Test/CN=Gommitlint Test CA" \
+  -addext "basicConstraints=critical,CA:TRUE" \
+  -addext "keyUsage=critical,keyCertSign,cRLSign" \
+  -out "$TESTDATA_DIR/x509-ca.pem"
+
+# Signing certificate for test@example.com
+openssl ecparam -name prime256v1 -genkey -noout -out "$TESTDATA_DIR/x509-signer.key"
+openssl req -new -key "$TESTDATA_DIR/x509-signer.key" \
+  -subj "/O=Gommitlint Test/CN=Test User/emailAddress=test@example.com" \
+  -out "$WORK_DIR/signer.csr"
+
+cat >"$WORK_DIR/signer.ext" <<EXT
+basicConstraints=critical,CA:FALSE
+keyUsage=critical,digitalSignature
+extendedKeyUsage=emailProtection,codeSigning
+subjectAltName=email:test@example.com
+EXT
+
+openssl x509 -req -in "$WORK_DIR/signer.csr" -CA "$TESTDATA_DIR/x509-ca.pem" \
+  -CAkey "$TESTDATA_DIR/x509-ca.key" -CAcreateserial -CAserial "$WORK_DIR/ca.srl" \
+  -sha256 -days 36500 -extfile "$WORK_DIR/signer.ext" \
+  -out "$TESTDATA_DIR/x509-signer.pem"
+
+echo "Generated CA: $TESTDATA_DIR/x509-ca.pem"
+echo "Generated signer: $TESTDATA_DIR/x509-signer.pem"
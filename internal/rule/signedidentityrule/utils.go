@@ -122,6 +122,31 @@ func getCommitBytes(commit *object.Commit) ([]byte, error) {
 	return io.ReadAll(reader)
 }
 
+// getTagBytes returns the tag data as bytes for signature verification.
+//
+// Parameters:
+//   - tag: The annotated tag object to encode
+//
+// Like getCommitBytes, the signature itself is excluded from the encoded data.
+//
+// Returns:
+//   - []byte: The encoded tag data ready for signature verification
+//   - error: Any error encountered during the encoding process
+func getTagBytes(tag *object.Tag) ([]byte, error) {
+	encoded := &plumbing.MemoryObject{}
+	if err := tag.EncodeWithoutSignature(encoded); err != nil {
+		return nil, fmt.Errorf("failed to encode tag: %w", err)
+	}
+
+	reader, err := encoded.Reader()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read tag: %w", err)
+	}
+	defer reader.Close()
+
+	return io.ReadAll(reader)
+}
+
 // findKeyFiles returns all files in a directory with specified extensions.
 //
 // Parameters:
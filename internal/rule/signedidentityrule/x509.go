@@ -0,0 +1,311 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package signedidentityrule
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	cms "github.com/github/smimesign/ietf-cms"
+	"github.com/github/smimesign/ietf-cms/protocol"
+)
+
+// x509SignaturePEMType is the PEM block type written by gpgsm (gpg.format=x509) and gitsign.
+const x509SignaturePEMType = "SIGNED MESSAGE"
+
+// CertificateError describes why an X.509 (CMS) signature could not be accepted.
+// Code is used as the validation error code of the SignedIdentity rule.
+type CertificateError struct {
+	Code    string
+	Message string
+	Context map[string]string
+}
+
+// Error implements the error interface.
+func (e *CertificateError) Error() string {
+	return e.Message
+}
+
+// newCertificateError creates a CertificateError with an initialised context.
+func newCertificateError(code, message string) *CertificateError {
+	return &CertificateError{
+		Code:    code,
+		Message: message,
+		Context: map[string]string{"signature_type": X509},
+	}
+}
+
+// verifyX509Signature verifies a CMS detached signature against commit data using a CA bundle.
+//
+// Parameters:
+//   - signerEmail: The committer or tagger email that must appear in the signing certificate
+//   - commitData: The raw commit data to verify
+//   - signature: The PEM encoded "SIGNED MESSAGE" block
+//   - caBundle: Path to a PEM file with the trusted CA certificates
+//   - policy: The key and hash algorithm policy the signing certificate must satisfy
+//
+// The function performs the following checks:
+//  1. The signature over the commit data is cryptographically valid
+//  2. The signing certificate chains to a CA in the bundle
+//  3. Every certificate in the chain is within its validity period
+//  4. The signing key and digest algorithm satisfy the key policy
+//  5. The certificate has an email SAN matching the signer email
+//
+// Validity is checked at the current time, or at the time of an embedded RFC 3161
+// timestamp when the signature carries one.
+//
+// Returns:
+//   - string: The identity of the signer ("Name <email>")
+//   - error: A *CertificateError or *KeyPolicyError describing the failure, if any
+func verifyX509Signature(signerEmail string, commitData []byte, signature string, caBundle string, policy KeyPolicy) (string, error) {
+	return verifyX509SignatureAt(signerEmail, commitData, signature, caBundle, policy, time.Time{})
+}
+
+// verifyX509SignatureAt is verifyX509Signature with an explicit verification time.
+// A zero time means the current time (or an embedded timestamp) is used.
+func verifyX509SignatureAt(signerEmail string, commitData []byte, signature string, caBundle string, policy KeyPolicy, verifyTime time.Time) (string, error) {
+	if caBundle == "" {
+		return "", newCertificateError("no_ca_bundle", "no CA bundle provided for X.509 signature verification")
+	}
+
+	roots, err := loadCABundle(caBundle)
+	if err != nil {
+		certErr := newCertificateError("invalid_ca_bundle", fmt.Sprintf("invalid CA bundle: %s", err))
+		certErr.Context["ca_bundle"] = caBundle
+		certErr.Context["error"] = err.Error()
+
+		return "", certErr
+	}
+
+	der, err := decodeX509Signature(signature)
+	if err != nil {
+		return "", err
+	}
+
+	signedData, signerInfo, err := parseCMSSignature(der)
+	if err != nil {
+		certErr := newCertificateError("invalid_signature_format", fmt.Sprintf("invalid X.509 signature format: %s", err))
+		certErr.Context["error"] = err.Error()
+
+		return "", certErr
+	}
+
+	// gpgsm and S/MIME signing certificates carry emailProtection, so TLS certificates
+	// from the same CA are not accepted
+	chains, err := signedData.VerifyDetached(commitData, x509.VerifyOptions{
+		Roots:       roots,
+		CurrentTime: verifyTime,
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageEmailProtection, x509.ExtKeyUsageCodeSigning},
+	})
+	if err != nil {
+		return "", classifyX509Error(err)
+	}
+
+	if len(chains) == 0 || len(chains[0]) == 0 || len(chains[0][0]) == 0 {
+		return "", newCertificateError("verification_failed", "X.509 signature verification returned no certificate chain")
+	}
+
+	leaf := chains[0][0][0]
+
+	if err := checkX509KeyPolicy(leaf, signerInfo, policy); err != nil {
+		return "", err
+	}
+
+	if err := checkCertificateEmail(leaf, signerEmail); err != nil {
+		return "", err
+	}
+
+	return certificateIdentity(leaf, signerEmail), nil
+}
+
+// loadCABundle reads a PEM file of trusted CA certificates into a pool.
+// The path must point to an existing regular file.
+func loadCABundle(path string) (*x509.CertPool, error) {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid path: %w", err)
+	}
+
+	fileInfo, err := os.Stat(absPath)
+	if err != nil {
+		return nil, fmt.Errorf("path error: %w", err)
+	}
+
+	if !fileInfo.Mode().IsRegular() {
+		return nil, fmt.Errorf("path is not a regular file: %s", absPath)
+	}
+
+	data, err := safeReadFile(absPath)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(data) {
+		return nil, fmt.Errorf("no PEM certificates found in %s", path)
+	}
+
+	return pool, nil
+}
+
+// decodeX509Signature extracts the DER encoded CMS structure from a PEM signature.
+func decodeX509Signature(signature string) ([]byte, error) {
+	block, _ := pem.Decode([]byte(strings.TrimSpace(signature)))
+	if block == nil || block.Type != x509SignaturePEMType {
+		return nil, newCertificateError("invalid_signature_format",
+			"invalid X.509 signature format: expected a '-----BEGIN SIGNED MESSAGE-----' block")
+	}
+
+	return block.Bytes, nil
+}
+
+// parseCMSSignature parses a DER encoded CMS SignedData structure and returns it with its first SignerInfo.
+// The CMS parser panics on some truncated inputs, so panics are turned into errors.
+func parseCMSSignature(der []byte) (signedData *cms.SignedData, signerInfo protocol.SignerInfo, err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			signedData = nil
+			err = fmt.Errorf("malformed CMS structure: %v", recovered)
+		}
+	}()
+
+	contentInfo, err := protocol.ParseContentInfo(der)
+	if err != nil {
+		return nil, protocol.SignerInfo{}, err
+	}
+
+	rawSignedData, err := contentInfo.SignedDataContent()
+	if err != nil {
+		return nil, protocol.SignerInfo{}, err
+	}
+
+	if len(rawSignedData.SignerInfos) == 0 {
+		return nil, protocol.SignerInfo{}, errors.New("no signer information found")
+	}
+
+	signedData, err = cms.ParseSignedData(der)
+	if err != nil {
+		return nil, protocol.SignerInfo{}, err
+	}
+
+	return signedData, rawSignedData.SignerInfos[0], nil
+}
+
+// classifyX509Error maps a CMS verification error to a CertificateError.
+func classifyX509Error(err error) *CertificateError {
+	var unknownAuthority x509.UnknownAuthorityError
+	if errors.As(err, &unknownAuthority) {
+		certErr := newCertificateError("certificate_untrusted",
+			"X.509 signing certificate does not chain to a trusted CA: "+err.Error())
+		certErr.Context["error"] = err.Error()
+
+		return certErr
+	}
+
+	var invalidCert x509.CertificateInvalidError
+	if errors.As(err, &invalidCert) {
+		code := "certificate_invalid"
+		if invalidCert.Reason == x509.Expired {
+			code = "certificate_expired"
+		}
+
+		certErr := newCertificateError(code, "X.509 signing certificate is not valid: "+err.Error())
+		certErr.Context["error"] = err.Error()
+
+		if invalidCert.Cert != nil {
+			certErr.Context["not_before"] = invalidCert.Cert.NotBefore.UTC().Format(time.RFC3339)
+			certErr.Context["not_after"] = invalidCert.Cert.NotAfter.UTC().Format(time.RFC3339)
+			certErr.Context["subject"] = invalidCert.Cert.Subject.String()
+		}
+
+		return certErr
+	}
+
+	certErr := newCertificateError("verification_failed", "X.509 signature verification failed: "+err.Error())
+	certErr.Context["error"] = err.Error()
+
+	return certErr
+}
+
+// checkX509KeyPolicy checks the signing certificate key and digest against the key policy.
+func checkX509KeyPolicy(cert *x509.Certificate, signerInfo protocol.SignerInfo, policy KeyPolicy) error {
+	algorithm, bits := x509KeyAlgorithm(cert)
+
+	if err := policy.checkKey(X509, algorithm, bits); err != nil {
+		return err
+	}
+
+	hash, err := signerInfo.Hash()
+	if err != nil {
+		return nil //nolint:nilerr // the digest was already used successfully during verification
+	}
+
+	return policy.checkHash(X509, hashName(hash))
+}
+
+// x509KeyAlgorithm returns the normalised algorithm name and bit length of a certificate key.
+func x509KeyAlgorithm(cert *x509.Certificate) (string, int) {
+	switch key := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		return AlgorithmRSA, key.N.BitLen()
+	case *ecdsa.PublicKey:
+		return AlgorithmECDSA, key.Curve.Params().BitSize
+	case ed25519.PublicKey:
+		return AlgorithmEd25519, 256 // Ed25519 is always 256 bits
+	default:
+		return strings.ToLower(cert.PublicKeyAlgorithm.String()), 0
+	}
+}
+
+// checkCertificateEmail verifies that the certificate carries the committer or tagger email.
+// Both the email SANs and a legacy emailAddress attribute in the subject are considered.
+func checkCertificateEmail(cert *x509.Certificate, email string) error {
+	for _, certEmail := range certificateEmails(cert) {
+		if strings.EqualFold(certEmail, email) {
+			return nil
+		}
+	}
+
+	certErr := newCertificateError("email_mismatch",
+		fmt.Sprintf("X.509 signing certificate is not issued to committer email %s", email))
+	certErr.Context["committer_email"] = email
+	certErr.Context["certificate_emails"] = strings.Join(certificateEmails(cert), ", ")
+
+	return certErr
+}
+
+// certificateEmails returns the email addresses a certificate is issued to.
+func certificateEmails(cert *x509.Certificate) []string {
+	emails := append([]string{}, cert.EmailAddresses...)
+
+	// OID 1.2.840.113549.1.9.1 is the PKCS #9 emailAddress attribute
+	for _, name := range cert.Subject.Names {
+		if name.Type.String() == "1.2.840.113549.1.9.1" {
+			if value, ok := name.Value.(string); ok {
+				emails = append(emails, value)
+			}
+		}
+	}
+
+	return emails
+}
+
+// certificateIdentity formats the signer identity as "Common Name <email>".
+func certificateIdentity(cert *x509.Certificate, email string) string {
+	if cert.Subject.CommonName == "" {
+		return email
+	}
+
+	return fmt.Sprintf("%s <%s>", cert.Subject.CommonName, email)
+}
@@ -0,0 +1,318 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+package signedidentityrule
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	cms "github.com/github/smimesign/ietf-cms"
+	"github.com/stretchr/testify/require"
+)
+
+// x509TestSigner signs commits with a CMS detached signature, as gpgsm does.
+type x509TestSigner struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+}
+
+func (s x509TestSigner) Sign(message io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(message)
+	if err != nil {
+		return nil, err
+	}
+
+	der, err := cms.SignDetached(data, []*x509.Certificate{s.cert}, s.key)
+	if err != nil {
+		return nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: x509SignaturePEMType, Bytes: der}), nil
+}
+
+// loadX509TestSigner loads the test signer certificate issued by testdata/x509-ca.pem.
+func loadX509TestSigner(t *testing.T) x509TestSigner {
+	t.Helper()
+
+	pair, err := tls.LoadX509KeyPair(filepath.Join("testdata", "x509-signer.pem"), filepath.Join("testdata", "x509-signer.key"))
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	require.NoError(t, err)
+
+	key, ok := pair.PrivateKey.(crypto.Signer)
+	require.True(t, ok)
+
+	return x509TestSigner{cert: cert, key: key}
+}
+
+// newX509TestSigner issues a certificate for email from the given CA, valid between notBefore and notAfter,
+// with the given extended key usages.
+func newX509TestSigner(t *testing.T, caCert *x509.Certificate, caKey crypto.Signer, email string,
+	notBefore, notAfter time.Time, extKeyUsage ...x509.ExtKeyUsage) x509TestSigner {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:   big.NewInt(time.Now().UnixNano()),
+		Subject:        pkix.Name{CommonName: "Test User"},
+		EmailAddresses: []string{email},
+		NotBefore:      notBefore,
+		NotAfter:       notAfter,
+		KeyUsage:       x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:    extKeyUsage,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, caCert, &key.PublicKey, caKey)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return x509TestSigner{cert: cert, key: key}
+}
+
+// loadX509TestCA loads the test CA certificate and key.
+func loadX509TestCA(t *testing.T) (*x509.Certificate, crypto.Signer) {
+	t.Helper()
+
+	pair, err := tls.LoadX509KeyPair(filepath.Join("testdata", "x509-ca.pem"), filepath.Join("testdata", "x509-ca.key"))
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(pair.Certificate[0])
+	require.NoError(t, err)
+
+	key, ok := pair.PrivateKey.(crypto.Signer)
+	require.True(t, ok)
+
+	return cert, key
+}
+
+// writeSelfSignedCA writes a freshly generated CA certificate to a PEM file and returns its path.
+func writeSelfSignedCA(t *testing.T) string {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Other CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "other-ca.pem")
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+
+	return path
+}
+
+func TestVerifyX509Signature(t *testing.T) {
+	caBundle, err := filepath.Abs(filepath.Join("testdata", "x509-ca.pem"))
+	require.NoError(t, err)
+
+	caCert, caKey := loadX509TestCA(t)
+
+	tests := []struct {
+		name       string
+		setupOpts  setupRepoOptions
+		caBundle   string
+		opts       []Option
+		wantCode   string
+		wantID     string
+		wantInHelp string
+	}{
+		{
+			name: "valid X.509 signed commit",
+			setupOpts: setupRepoOptions{
+				authorName:  "Test User",
+				authorEmail: "test@example.com",
+				message:     "Signed commit",
+				signer:      loadX509TestSigner(t),
+			},
+			caBundle: caBundle,
+			wantID:   "Test User <test@example.com>",
+		},
+		{
+			name: "certificate email matches case insensitively",
+			setupOpts: setupRepoOptions{
+				authorName:  "Test User",
+				authorEmail: "Test@Example.com",
+				message:     "Signed commit",
+				signer:      loadX509TestSigner(t),
+			},
+			caBundle: caBundle,
+			wantID:   "Test User <Test@Example.com>",
+		},
+		{
+			name: "missing CA bundle",
+			setupOpts: setupRepoOptions{
+				authorName:  "Test User",
+				authorEmail: "test@example.com",
+				message:     "Signed commit",
+				signer:      loadX509TestSigner(t),
+			},
+			wantCode:   "no_ca_bundle",
+			wantInHelp: "ca-bundle",
+		},
+		{
+			name: "unreadable CA bundle",
+			setupOpts: setupRepoOptions{
+				authorName:  "Test User",
+				authorEmail: "test@example.com",
+				message:     "Signed commit",
+				signer:      loadX509TestSigner(t),
+			},
+			caBundle: filepath.Join(t.TempDir(), "missing.pem"),
+			wantCode: "invalid_ca_bundle",
+		},
+		{
+			name: "certificate from untrusted CA",
+			setupOpts: setupRepoOptions{
+				authorName:  "Test User",
+				authorEmail: "test@example.com",
+				message:     "Signed commit",
+				signer:      loadX509TestSigner(t),
+			},
+			caBundle:   writeSelfSignedCA(t),
+			wantCode:   "certificate_untrusted",
+			wantInHelp: "trusted CA",
+		},
+		{
+			name: "expired certificate",
+			setupOpts: setupRepoOptions{
+				authorName:  "Test User",
+				authorEmail: "test@example.com",
+				message:     "Signed commit",
+				signer: newX509TestSigner(t, caCert, caKey, "test@example.com",
+					time.Now().Add(-48*time.Hour), time.Now().Add(-24*time.Hour), x509.ExtKeyUsageEmailProtection),
+			},
+			caBundle:   caBundle,
+			wantCode:   "certificate_expired",
+			wantInHelp: "Renew the certificate",
+		},
+		{
+			name: "TLS certificate from the trusted CA",
+			setupOpts: setupRepoOptions{
+				authorName:  "Test User",
+				authorEmail: "test@example.com",
+				message:     "Signed commit",
+				signer: newX509TestSigner(t, caCert, caKey, "test@example.com",
+					time.Now().Add(-time.Hour), time.Now().Add(time.Hour),
+					x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth),
+			},
+			caBundle:   caBundle,
+			wantCode:   "certificate_invalid",
+			wantInHelp: "email protection",
+		},
+		{
+			name: "code signing certificate",
+			setupOpts: setupRepoOptions{
+				authorName:  "Test User",
+				authorEmail: "test@example.com",
+				message:     "Signed commit",
+				signer: newX509TestSigner(t, caCert, caKey, "test@example.com",
+					time.Now().Add(-time.Hour), time.Now().Add(time.Hour), x509.ExtKeyUsageCodeSigning),
+			},
+			caBundle: caBundle,
+			wantID:   "Test User <test@example.com>",
+		},
+		{
+			name: "certificate issued to another email",
+			setupOpts: setupRepoOptions{
+				authorName:  "Other User",
+				authorEmail: "other@example.com",
+				message:     "Signed commit",
+				signer:      loadX509TestSigner(t),
+			},
+			caBundle:   caBundle,
+			wantCode:   "email_mismatch",
+			wantInHelp: "committer email",
+		},
+		{
+			name: "key policy applies to certificate key",
+			setupOpts: setupRepoOptions{
+				authorName:  "Test User",
+				authorEmail: "test@example.com",
+				message:     "Signed commit",
+				signer:      loadX509TestSigner(t),
+			},
+			caBundle: caBundle,
+			opts:     []Option{WithKeyPolicy(KeyPolicy{AllowedAlgorithms: []string{"rsa"}})},
+			wantCode: PolicyReasonDisallowedAlgorithm,
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			_, commit := setupTestRepo(t, tabletest.setupOpts)
+
+			opts := append([]Option{WithCABundle(tabletest.caBundle)}, tabletest.opts...)
+
+			// No key directory is needed for X.509 signatures
+			result := VerifySignatureIdentity(commit, commit.PGPSignature, "", opts...)
+
+			require.Equal(t, X509, result.SignatureType)
+
+			if tabletest.wantCode == "" {
+				require.Empty(t, result.Errors(), "Expected no errors but got: %v", result.Errors())
+				require.Equal(t, tabletest.wantID, result.Identity)
+
+				return
+			}
+
+			require.Len(t, result.Errors(), 1)
+			require.Equal(t, tabletest.wantCode, result.Errors()[0].Code)
+			require.Equal(t, X509, result.Errors()[0].Context["signature_type"])
+
+			if tabletest.wantInHelp != "" {
+				require.Contains(t, result.Help(), tabletest.wantInHelp)
+			}
+		})
+	}
+}
+
+func TestVerifyX509SignatureTamperedCommit(t *testing.T) {
+	caBundle, err := filepath.Abs(filepath.Join("testdata", "x509-ca.pem"))
+	require.NoError(t, err)
+
+	_, commit := setupTestRepo(t, setupRepoOptions{
+		authorName:  "Test User",
+		authorEmail: "test@example.com",
+		message:     "Signed commit",
+		signer:      loadX509TestSigner(t),
+	})
+
+	commit.Message = "Tampered commit"
+
+	result := VerifySignatureIdentity(commit, commit.PGPSignature, "", WithCABundle(caBundle))
+
+	require.Len(t, result.Errors(), 1)
+	require.Equal(t, "verification_failed", result.Errors()[0].Code)
+}
+
+func TestDetectSignatureTypeX509(t *testing.T) {
+	require.Equal(t, X509, detectSignatureType("-----BEGIN SIGNED MESSAGE-----\nMIID\n-----END SIGNED MESSAGE-----"))
+}
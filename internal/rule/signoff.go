@@ -5,15 +5,77 @@
 package rule
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 
 	"github.com/itiquette/gommitlint/internal/model"
 )
 
+// signOffValuePattern matches the value of a sign-off, "Name <email@example.com>".
+// It is shared by SignOffRegex and SignOffTrailer.
+const signOffValuePattern = `([^<]+) <([^<>@]+@[^<>]+)>`
+
 // SignOffRegex is the regular expression used to validate the Developer Certificate of Origin signature.
 // It matches the standard format "Signed-off-by: Name <email@example.com>".
-var SignOffRegex = regexp.MustCompile(`^Signed-off-by: ([^<]+) <([^<>@]+@[^<>]+)>$`)
+var SignOffRegex = regexp.MustCompile(`^Signed-off-by: ` + signOffValuePattern + `$`)
+
+// SignOffConfig provides configuration for the SignOff rule.
+type SignOffConfig struct {
+	// Author, if set, must have signed off the commit
+	Author *model.Identity
+
+	// Committer, if set, must have signed off the commit
+	Committer *model.Identity
+
+	// RequireCoAuthors requires a sign-off from every Co-authored-by trailer
+	RequireCoAuthors bool
+
+	// IgnoreEmailCase compares email addresses case-insensitively
+	IgnoreEmailCase bool
+
+	// IgnorePlusTag ignores "+tag" suffixes in the local part of email addresses
+	IgnorePlusTag bool
+}
+
+// DefaultSignOffConfig returns the default configuration, which only checks the sign-off format.
+func DefaultSignOffConfig() SignOffConfig {
+	return SignOffConfig{
+		IgnoreEmailCase: true,
+	}
+}
+
+// SignOffOption configures a SignOffConfig.
+type SignOffOption func(*SignOffConfig)
+
+// WithSignOffAuthor requires a sign-off matching the commit author.
+func WithSignOffAuthor(name, email string) SignOffOption {
+	return func(c *SignOffConfig) {
+		c.Author = &model.Identity{Name: name, Email: email}
+	}
+}
+
+// WithSignOffCommitter requires a sign-off matching the committer.
+func WithSignOffCommitter(name, email string) SignOffOption {
+	return func(c *SignOffConfig) {
+		c.Committer = &model.Identity{Name: name, Email: email}
+	}
+}
+
+// WithCoAuthorSignOffs sets whether every Co-authored-by trailer needs a matching sign-off.
+func WithCoAuthorSignOffs(require bool) SignOffOption {
+	return func(c *SignOffConfig) {
+		c.RequireCoAuthors = require
+	}
+}
+
+// WithEmailNormalization sets how email addresses are compared.
+func WithEmailNormalization(ignoreCase, ignorePlusTag bool) SignOffOption {
+	return func(c *SignOffConfig) {
+		c.IgnoreEmailCase = ignoreCase
+		c.IgnorePlusTag = ignorePlusTag
+	}
+}
 
 // SignOff enforces the presence and format of a Developer Certificate of Origin (DCO) sign-off
 // in commit messages.
@@ -57,10 +119,17 @@ var SignOffRegex = regexp.MustCompile(`^Signed-off-by: ([^<]+) <([^<>@]+@[^<>]+)
 //
 //     Signed by: John Smith (john@example.com)
 //     ```
+//
+// Optionally the rule verifies who signed off: the commit author, the committer and
+// every Co-authored-by trailer can be required to have a matching sign-off. A sign-off
+// matches a person when the email addresses are equal (after the configured
+// normalisation), or, when no email is known, when the names are equal.
 type SignOff struct {
 	errors              []*model.ValidationError
 	hasAttemptedSignOff bool   // Track if there was an attempt at signing off
 	foundSignOff        string // Store the found sign-off for verbose output
+	signOffs            []model.Identity
+	config              SignOffConfig
 }
 
 // Name returns the name of the rule.
@@ -71,6 +140,11 @@ func (SignOff) Name() string {
 // Result returns a concise rule message.
 func (rule SignOff) Result() string {
 	if len(rule.errors) != 0 {
+		switch rule.errors[0].Code {
+		case "signoff_author_mismatch", "signoff_committer_mismatch", "missing_coauthor_signoff":
+			return "Sign-off identity mismatch"
+		}
+
 		return "Missing sign-off"
 	}
 
@@ -87,11 +161,18 @@ func (rule SignOff) VerboseResult() string {
 			return "No Developer Certificate of Origin sign-off found in commit message. Add 'Signed-off-by: Name <email@example.com>'."
 		case "invalid_format":
 			return "Attempted sign-off has incorrect format. Must be exactly: 'Signed-off-by: Name <email@example.com>'."
+		case "signoff_author_mismatch", "signoff_committer_mismatch", "missing_coauthor_signoff":
+			return fmt.Sprintf("No sign-off from %s. Found sign-offs: %s",
+				rule.errors[0].Context["expected"], rule.errors[0].Context["signoffs"])
 		default:
 			return rule.errors[0].Error()
 		}
 	}
 
+	if rule.foundSignOff != "" && rule.config.Author != nil {
+		return "Valid Developer Certificate of Origin sign-off from the author found: " + rule.foundSignOff
+	}
+
 	if rule.foundSignOff != "" {
 		return "Valid Developer Certificate of Origin sign-off found: " + rule.foundSignOff
 	}
@@ -167,6 +248,24 @@ Common issues include:
 - Using incorrect email format
 
 You can add a correct sign-off automatically using 'git commit -s'`
+
+		case "signoff_author_mismatch":
+			return fmt.Sprintf(`The commit must be signed off by its author, %s.
+The Developer Certificate of Origin has to be certified by the person who wrote the change.
+
+If you are the author, check that 'git config user.email' matches the email in your
+sign-off and amend the commit with 'git commit --amend -s'.
+If you are submitting someone else's change, keep their sign-off and add your own.`, rule.errors[0].Context["expected"])
+
+		case "signoff_committer_mismatch":
+			return fmt.Sprintf(`The commit must also be signed off by its committer, %s.
+Add your sign-off with 'git commit --amend -s'.`, rule.errors[0].Context["expected"])
+
+		case "missing_coauthor_signoff":
+			return fmt.Sprintf(`Every co-author must sign off the commit. Add a sign-off for %s:
+
+Co-authored-by: Name <email@example.com>
+Signed-off-by: Name <email@example.com>`, rule.errors[0].Context["expected"])
 		}
 	}
 
@@ -208,10 +307,18 @@ Signed-off-by: Jane Doe <jane.doe@example.org>`
 //   - Invalid email format
 //   - Empty commit message
 //
+// Options can additionally require sign-offs from the author, committer and co-authors.
+// Without options only the format is checked.
+//
 // Returns:
 //   - A SignOff instance with validation results
-func ValidateSignOff(body string) *SignOff {
-	rule := &SignOff{}
+func ValidateSignOff(body string, opts ...SignOffOption) *SignOff {
+	config := DefaultSignOffConfig()
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	rule := &SignOff{config: config}
 
 	// Handle empty body
 	if strings.TrimSpace(body) == "" {
@@ -228,13 +335,21 @@ func ValidateSignOff(body string) *SignOff {
 	allLines := strings.Split(body, "\n")
 	for _, line := range allLines {
 		trimmedLine := strings.TrimSpace(line)
-		if SignOffRegex.MatchString(trimmedLine) {
-			rule.foundSignOff = trimmedLine
+		if match := SignOffRegex.FindStringSubmatch(trimmedLine); match != nil {
+			if rule.foundSignOff == "" {
+				rule.foundSignOff = trimmedLine
+			}
 
-			return rule // Found a valid sign-off
+			rule.signOffs = append(rule.signOffs, model.Identity{Name: strings.TrimSpace(match[1]), Email: match[2]})
 		}
 	}
 
+	if rule.foundSignOff != "" {
+		rule.checkIdentities(body)
+
+		return rule // Found a valid sign-off
+	}
+
 	// Check if there are any lines that attempt to be a sign-off but are formatted incorrectly
 	rule.hasAttemptedSignOff = false
 
@@ -268,3 +383,85 @@ func ValidateSignOff(body string) *SignOff {
 
 	return rule
 }
+
+// checkIdentities verifies that the configured people have signed off.
+func (rule *SignOff) checkIdentities(body string) {
+	if rule.config.Author != nil && !rule.hasSignOffFrom(*rule.config.Author) {
+		rule.addIdentityError("signoff_author_mismatch", "author", *rule.config.Author)
+	}
+
+	if rule.config.Committer != nil && !rule.hasSignOffFrom(*rule.config.Committer) {
+		rule.addIdentityError("signoff_committer_mismatch", "committer", *rule.config.Committer)
+	}
+
+	if !rule.config.RequireCoAuthors {
+		return
+	}
+
+	// The body is parsed as a message with an empty subject, so that its first
+	// paragraph can be the trailer block. Malformed co-authors are left to CoAuthors.
+	for _, trailer := range coAuthorTrailers(model.ParseTrailers("\n\n" + body)) {
+		coAuthor, valid := parseCoAuthor(trailer.Value)
+		if !valid {
+			continue
+		}
+
+		if !rule.hasSignOffFrom(coAuthor) {
+			rule.addIdentityError("missing_coauthor_signoff", "co-author", coAuthor)
+		}
+	}
+}
+
+// addIdentityError adds an error for a person without a matching sign-off.
+func (rule *SignOff) addIdentityError(code, role string, identity model.Identity) {
+	signOffs := make([]string, 0, len(rule.signOffs))
+	for _, signOff := range rule.signOffs {
+		signOffs = append(signOffs, signOff.String())
+	}
+
+	rule.addError(
+		code,
+		fmt.Sprintf("commit must be signed off by the %s %s", role, identity),
+		map[string]string{
+			"expected": identity.String(),
+			"signoffs": strings.Join(signOffs, ", "),
+		},
+	)
+}
+
+// hasSignOffFrom reports whether any sign-off matches the identity.
+func (rule *SignOff) hasSignOffFrom(identity model.Identity) bool {
+	for _, signOff := range rule.signOffs {
+		if identity.Email != "" {
+			if rule.normalizeEmail(signOff.Email) == rule.normalizeEmail(identity.Email) {
+				return true
+			}
+
+			continue
+		}
+
+		if strings.EqualFold(signOff.Name, strings.TrimSpace(identity.Name)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeEmail applies the configured email normalisation.
+func (rule *SignOff) normalizeEmail(email string) string {
+	email = strings.TrimSpace(email)
+
+	if rule.config.IgnorePlusTag {
+		if local, domain, found := strings.Cut(email, "@"); found {
+			local, _, _ = strings.Cut(local, "+")
+			email = local + "@" + domain
+		}
+	}
+
+	if rule.config.IgnoreEmailCase {
+		email = strings.ToLower(email)
+	}
+
+	return email
+}
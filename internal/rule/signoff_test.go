@@ -208,3 +208,127 @@ Signed-off-by: Test User <test@example.com>`)
 		}
 	})
 }
+
+func TestValidateSignOffIdentity(t *testing.T) {
+	tests := []struct {
+		name       string
+		message    string
+		opts       []rule.SignOffOption
+		wantCodes  []string
+		wantInHelp string
+	}{
+		{
+			name:    "sign-off matches author",
+			message: "Add feature\n\nSigned-off-by: Jane Doe <jane@example.com>",
+			opts:    []rule.SignOffOption{rule.WithSignOffAuthor("Jane Doe", "jane@example.com")},
+		},
+		{
+			name:       "sign-off from someone else",
+			message:    "Add feature\n\nSigned-off-by: John Smith <john@example.com>",
+			opts:       []rule.SignOffOption{rule.WithSignOffAuthor("Jane Doe", "jane@example.com")},
+			wantCodes:  []string{"signoff_author_mismatch"},
+			wantInHelp: "Jane Doe <jane@example.com>",
+		},
+		{
+			name:    "author among several sign-offs",
+			message: "Add feature\n\nSigned-off-by: John Smith <john@example.com>\nSigned-off-by: Jane Doe <jane@example.com>",
+			opts:    []rule.SignOffOption{rule.WithSignOffAuthor("Jane Doe", "jane@example.com")},
+		},
+		{
+			name:    "email case is ignored by default",
+			message: "Add feature\n\nSigned-off-by: Jane Doe <Jane@Example.com>",
+			opts:    []rule.SignOffOption{rule.WithSignOffAuthor("Jane Doe", "jane@example.com")},
+		},
+		{
+			name:    "email case is compared when configured",
+			message: "Add feature\n\nSigned-off-by: Jane Doe <Jane@Example.com>",
+			opts: []rule.SignOffOption{
+				rule.WithSignOffAuthor("Jane Doe", "jane@example.com"),
+				rule.WithEmailNormalization(false, false),
+			},
+			wantCodes: []string{"signoff_author_mismatch"},
+		},
+		{
+			name:    "plus tag is ignored when configured",
+			message: "Add feature\n\nSigned-off-by: Jane Doe <jane+oss@example.com>",
+			opts: []rule.SignOffOption{
+				rule.WithSignOffAuthor("Jane Doe", "jane@example.com"),
+				rule.WithEmailNormalization(true, true),
+			},
+		},
+		{
+			name:      "plus tag is significant by default",
+			message:   "Add feature\n\nSigned-off-by: Jane Doe <jane+oss@example.com>",
+			opts:      []rule.SignOffOption{rule.WithSignOffAuthor("Jane Doe", "jane@example.com")},
+			wantCodes: []string{"signoff_author_mismatch"},
+		},
+		{
+			name:    "author without email matches by name",
+			message: "Add feature\n\nSigned-off-by: Jane Doe <jane@example.com>",
+			opts:    []rule.SignOffOption{rule.WithSignOffAuthor("Jane Doe", "")},
+		},
+		{
+			name:    "committer must sign off too",
+			message: "Add feature\n\nSigned-off-by: Jane Doe <jane@example.com>",
+			opts: []rule.SignOffOption{
+				rule.WithSignOffAuthor("Jane Doe", "jane@example.com"),
+				rule.WithSignOffCommitter("John Smith", "john@example.com"),
+			},
+			wantCodes:  []string{"signoff_committer_mismatch"},
+			wantInHelp: "committer",
+		},
+		{
+			name:    "co-author with sign-off",
+			message: "Add feature\n\nCo-authored-by: John Smith <john@example.com>\nSigned-off-by: Jane Doe <jane@example.com>\nSigned-off-by: John Smith <john@example.com>",
+			opts: []rule.SignOffOption{
+				rule.WithSignOffAuthor("Jane Doe", "jane@example.com"),
+				rule.WithCoAuthorSignOffs(true),
+			},
+		},
+		{
+			name:    "co-author without sign-off",
+			message: "Add feature\n\nCo-authored-by: John Smith <john@example.com>\nSigned-off-by: Jane Doe <jane@example.com>",
+			opts: []rule.SignOffOption{
+				rule.WithSignOffAuthor("Jane Doe", "jane@example.com"),
+				rule.WithCoAuthorSignOffs(true),
+			},
+			wantCodes:  []string{"missing_coauthor_signoff"},
+			wantInHelp: "John Smith <john@example.com>",
+		},
+		{
+			name:    "co-authors are not checked by default",
+			message: "Add feature\n\nCo-authored-by: John Smith <john@example.com>\nSigned-off-by: Jane Doe <jane@example.com>",
+			opts:    []rule.SignOffOption{rule.WithSignOffAuthor("Jane Doe", "jane@example.com")},
+		},
+		{
+			name:      "missing sign-off is reported before identity",
+			message:   "Add feature\n\nImplement it",
+			opts:      []rule.SignOffOption{rule.WithSignOffAuthor("Jane Doe", "jane@example.com")},
+			wantCodes: []string{"missing_signoff"},
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			result := rule.ValidateSignOff(tabletest.message, tabletest.opts...)
+
+			codes := make([]string, 0, len(result.Errors()))
+			for _, err := range result.Errors() {
+				codes = append(codes, err.Code)
+			}
+
+			if len(tabletest.wantCodes) == 0 {
+				require.Empty(t, codes)
+				require.Equal(t, "Sign-off exists", result.Result())
+
+				return
+			}
+
+			require.Equal(t, tabletest.wantCodes, codes)
+
+			if tabletest.wantInHelp != "" {
+				require.Contains(t, result.Help(), tabletest.wantInHelp)
+			}
+		})
+	}
+}
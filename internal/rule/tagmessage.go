@@ -0,0 +1,107 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/model"
+)
+
+// TagMessage enforces that an annotated tag has a non-empty message.
+//
+// The tag message is what "git show <tag>" and most forges display as the
+// release notes, so it should at least state what is being released.
+// The signature block of a signed tag does not count as message content.
+//
+// Examples:
+//
+//   - "Release v1.2.0" would pass
+//   - "" or a message containing only whitespace would fail
+type TagMessage struct {
+	tagName string
+	subject string
+	errors  []*model.ValidationError
+}
+
+// Name returns the name of the rule.
+func (rule TagMessage) Name() string {
+	return "TagMessage"
+}
+
+// Result returns a concise rule message.
+func (rule TagMessage) Result() string {
+	if len(rule.errors) > 0 {
+		return "Empty tag message"
+	}
+
+	return "Valid tag message"
+}
+
+// VerboseResult returns a more detailed explanation for verbose mode.
+func (rule TagMessage) VerboseResult() string {
+	if len(rule.errors) > 0 {
+		return fmt.Sprintf("Tag '%s' has no message.", rule.tagName)
+	}
+
+	return fmt.Sprintf("Tag '%s' has message: %s", rule.tagName, rule.subject)
+}
+
+// addError adds a structured validation error.
+func (rule *TagMessage) addError(code, message string, context map[string]string) {
+	err := model.NewValidationError("TagMessage", code, message)
+
+	for key, value := range context {
+		_ = err.WithContext(key, value)
+	}
+
+	rule.errors = append(rule.errors, err)
+}
+
+// Errors returns validation errors.
+func (rule TagMessage) Errors() []*model.ValidationError {
+	return rule.errors
+}
+
+// Help returns a description of how to fix the rule violation.
+func (rule TagMessage) Help() string {
+	if len(rule.errors) == 0 {
+		return "No errors to fix"
+	}
+
+	return fmt.Sprintf(`Recreate the tag with a message describing the release:
+
+git tag -f -s %[1]s -m "Release %[1]s" %[1]s^{}`, rule.tagName)
+}
+
+// ValidateTagMessage checks that a tag message is not empty.
+//
+// Parameters:
+//   - tagName: The short name of the tag
+//   - message: The tag message, without any signature
+//
+// Returns:
+//   - A TagMessage instance with validation results
+func ValidateTagMessage(tagName, message string) *TagMessage {
+	subject, _ := model.SplitCommitMessage(strings.TrimSpace(message))
+
+	rule := &TagMessage{
+		tagName: tagName,
+		subject: subject,
+	}
+
+	if strings.TrimSpace(message) == "" {
+		rule.addError(
+			"empty_tag_message",
+			fmt.Sprintf("tag %s has an empty message", tagName),
+			map[string]string{
+				"tag": tagName,
+			},
+		)
+	}
+
+	return rule
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+package rule_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/rule"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTagMessage(t *testing.T) {
+	testCases := []struct {
+		name          string
+		message       string
+		expectedValid bool
+	}{
+		{name: "Single line message", message: "Release v1.0.0\n", expectedValid: true},
+		{name: "Message with body", message: "Release v1.0.0\n\nFirst stable release.\n", expectedValid: true},
+		{name: "Empty message", message: "", expectedValid: false},
+		{name: "Whitespace only message", message: " \n\t\n", expectedValid: false},
+	}
+
+	for _, tabletest := range testCases {
+		t.Run(tabletest.name, func(t *testing.T) {
+			result := rule.ValidateTagMessage("v1.0.0", tabletest.message)
+
+			assert.Equal(t, "TagMessage", result.Name())
+
+			if tabletest.expectedValid {
+				assert.Empty(t, result.Errors())
+				assert.Equal(t, "Valid tag message", result.Result())
+				assert.Contains(t, result.VerboseResult(), "Release v1.0.0")
+
+				return
+			}
+
+			require.Len(t, result.Errors(), 1)
+			assert.Equal(t, "empty_tag_message", result.Errors()[0].Code)
+			assert.Equal(t, "Empty tag message", result.Result())
+			assert.Contains(t, result.Help(), "git tag")
+		})
+	}
+}
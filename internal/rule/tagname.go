@@ -0,0 +1,154 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/itiquette/gommitlint/internal/model"
+)
+
+// DefaultTagNamePattern matches a Semantic Versioning 2.0.0 version with an optional "v" prefix.
+const DefaultTagNamePattern = `^v?(0|[1-9]\d*)\.(0|[1-9]\d*)\.(0|[1-9]\d*)` +
+	`(?:-[0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*)?(?:\+[0-9A-Za-z-]+(?:\.[0-9A-Za-z-]+)*)?$`
+
+// TagName enforces that tag names follow a naming scheme, by default semantic versioning.
+//
+// Consistent tag names let release tooling, changelog generators and package
+// managers find and order releases reliably.
+//
+// Examples:
+//
+//   - With the default pattern:
+//     "v1.2.3", "1.2.3" and "v2.0.0-rc.1+build.5" would pass
+//     "release-1.2", "v1.2" and "v01.2.3" would fail
+type TagName struct {
+	tagName string
+	pattern string
+	errors  []*model.ValidationError
+}
+
+// Name returns the name of the rule.
+func (rule TagName) Name() string {
+	return "TagName"
+}
+
+// Result returns a concise rule message.
+func (rule TagName) Result() string {
+	if len(rule.errors) > 0 {
+		return "Invalid tag name"
+	}
+
+	return "Valid tag name"
+}
+
+// VerboseResult returns a more detailed explanation for verbose mode.
+func (rule TagName) VerboseResult() string {
+	if len(rule.errors) > 0 {
+		switch rule.errors[0].Code {
+		case "invalid_pattern":
+			return fmt.Sprintf("Configured tag name pattern '%s' is not a valid regular expression.", rule.pattern)
+		case "invalid_tag_name":
+			if rule.pattern == DefaultTagNamePattern {
+				return fmt.Sprintf("Tag '%s' is not a semantic version (e.g. v1.2.3).", rule.tagName)
+			}
+
+			return fmt.Sprintf("Tag '%s' does not match the pattern '%s'.", rule.tagName, rule.pattern)
+		default:
+			return rule.errors[0].Error()
+		}
+	}
+
+	return fmt.Sprintf("Tag '%s' matches the pattern '%s'.", rule.tagName, rule.pattern)
+}
+
+// addError adds a structured validation error.
+func (rule *TagName) addError(code, message string, context map[string]string) {
+	err := model.NewValidationError("TagName", code, message)
+
+	for key, value := range context {
+		_ = err.WithContext(key, value)
+	}
+
+	rule.errors = append(rule.errors, err)
+}
+
+// Errors returns validation errors.
+func (rule TagName) Errors() []*model.ValidationError {
+	return rule.errors
+}
+
+// Help returns a description of how to fix the rule violation.
+func (rule TagName) Help() string {
+	if len(rule.errors) == 0 {
+		return "No errors to fix"
+	}
+
+	switch rule.errors[0].Code {
+	case "invalid_pattern":
+		return "Fix the 'tag.name-pattern' setting in your configuration so that it is a valid Go regular expression"
+	case "invalid_tag_name":
+		if rule.pattern == DefaultTagNamePattern {
+			return `Name release tags after a semantic version, optionally prefixed with "v".
+Examples: v1.2.3, 1.2.3, v2.0.0-rc.1
+
+Rename a tag with:
+git tag <new-name> <old-name>
+git tag -d <old-name>`
+		}
+
+		return fmt.Sprintf("Rename the tag so that it matches the configured pattern: %s", rule.pattern)
+	}
+
+	return "Review and fix the tag name according to the guidelines"
+}
+
+// ValidateTagName checks if a tag name matches pattern.
+// If pattern is empty, DefaultTagNamePattern is used.
+//
+// Parameters:
+//   - tagName: The short name of the tag
+//   - pattern: A regular expression the whole tag name must match
+//
+// Returns:
+//   - A TagName instance with validation results
+func ValidateTagName(tagName, pattern string) *TagName {
+	if pattern == "" {
+		pattern = DefaultTagNamePattern
+	}
+
+	rule := &TagName{
+		tagName: tagName,
+		pattern: pattern,
+	}
+
+	nameRegex, err := regexp.Compile(pattern)
+	if err != nil {
+		rule.addError(
+			"invalid_pattern",
+			fmt.Sprintf("invalid tag name pattern %q: %s", pattern, err),
+			map[string]string{
+				"pattern": pattern,
+				"error":   err.Error(),
+			},
+		)
+
+		return rule
+	}
+
+	if !nameRegex.MatchString(tagName) {
+		rule.addError(
+			"invalid_tag_name",
+			fmt.Sprintf("tag name %q does not match pattern %q", tagName, pattern),
+			map[string]string{
+				"tag":     tagName,
+				"pattern": pattern,
+			},
+		)
+	}
+
+	return rule
+}
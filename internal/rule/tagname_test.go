@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+package rule_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/rule"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTagName(t *testing.T) {
+	testCases := []struct {
+		name         string
+		tagName      string
+		pattern      string
+		expectedCode string
+	}{
+		{name: "Semantic version with v prefix", tagName: "v1.2.3"},
+		{name: "Semantic version without prefix", tagName: "1.2.3"},
+		{name: "Pre-release and build metadata", tagName: "v2.0.0-rc.1+build.5"},
+		{name: "Missing patch version", tagName: "v1.2", expectedCode: "invalid_tag_name"},
+		{name: "Leading zero", tagName: "v01.2.3", expectedCode: "invalid_tag_name"},
+		{name: "Free form name", tagName: "release-1.2.3", expectedCode: "invalid_tag_name"},
+		{name: "Empty pre-release identifier", tagName: "v1.2.3-", expectedCode: "invalid_tag_name"},
+		{name: "Custom pattern match", tagName: "release/2025.01", pattern: `^release/\d{4}\.\d{2}$`},
+		{name: "Custom pattern mismatch", tagName: "v1.2.3", pattern: `^release/\d{4}\.\d{2}$`, expectedCode: "invalid_tag_name"},
+		{name: "Invalid custom pattern", tagName: "v1.2.3", pattern: `^(v`, expectedCode: "invalid_pattern"},
+	}
+
+	for _, tabletest := range testCases {
+		t.Run(tabletest.name, func(t *testing.T) {
+			result := rule.ValidateTagName(tabletest.tagName, tabletest.pattern)
+
+			assert.Equal(t, "TagName", result.Name())
+
+			if tabletest.expectedCode == "" {
+				assert.Empty(t, result.Errors())
+				assert.Equal(t, "Valid tag name", result.Result())
+				assert.Equal(t, "No errors to fix", result.Help())
+
+				return
+			}
+
+			require.Len(t, result.Errors(), 1)
+			assert.Equal(t, tabletest.expectedCode, result.Errors()[0].Code)
+			assert.Equal(t, "Invalid tag name", result.Result())
+			assert.NotEmpty(t, result.VerboseResult())
+			assert.NotEqual(t, "No errors to fix", result.Help())
+		})
+	}
+}
+
+func TestTagNameHelpMentionsPattern(t *testing.T) {
+	defaultResult := rule.ValidateTagName("latest", "")
+	assert.Contains(t, defaultResult.VerboseResult(), "semantic version")
+	assert.Contains(t, defaultResult.Help(), "v1.2.3")
+
+	customResult := rule.ValidateTagName("latest", `^release-\d+$`)
+	assert.Contains(t, customResult.VerboseResult(), `^release-\d+$`)
+	assert.Contains(t, customResult.Help(), `^release-\d+$`)
+}
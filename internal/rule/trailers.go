@@ -0,0 +1,384 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/model"
+)
+
+// TrailerSpec declares the policy for one trailer key.
+type TrailerSpec struct {
+	// Key is the trailer key, compared case-insensitively, e.g. "Reviewed-by"
+	Key string
+
+	// Required means the trailer must appear at least once (same as Min: 1)
+	Required bool
+
+	// Forbidden means the trailer must not appear
+	Forbidden bool
+
+	// Pattern is a regular expression every value of the trailer must match
+	Pattern string
+
+	// Min and Max limit how often the trailer may appear (0 means no limit)
+	Min int
+	Max int
+}
+
+// TrailersConfig provides configuration for the Trailers rule.
+type TrailersConfig struct {
+	// Specs lists the policies for individual trailer keys
+	Specs []TrailerSpec
+
+	// Order lists trailer keys in the order they must appear in the trailer block.
+	// Keys that are not listed may appear anywhere.
+	Order []string
+
+	// RequireFinalParagraph rejects declared trailers outside the trailer block
+	RequireFinalParagraph bool
+}
+
+// SignOffTrailer returns the trailer specification equivalent to ValidateSignOff.
+// Its pattern is the value part of SignOffRegex.
+func SignOffTrailer() TrailerSpec {
+	return TrailerSpec{
+		Key:      "Signed-off-by",
+		Required: true,
+		Pattern:  `^` + signOffValuePattern + `$`,
+	}
+}
+
+// JiraRefsTrailer returns the trailer specification equivalent to the "Refs:" body check
+// of JiraReference. Combine it with Order ["Refs", "Signed-off-by"] to require that
+// references come before sign-offs. Keys are matched with the same pattern as JiraReference.
+func JiraRefsTrailer() TrailerSpec {
+	return TrailerSpec{
+		Key:      "Refs",
+		Required: true,
+		Pattern:  `^` + jiraRefsPattern + `$`,
+	}
+}
+
+// Trailers validates the trailer block of a commit message against declared policies.
+//
+// Trailers are the "Key: value" lines in the final paragraph of a commit message,
+// e.g. "Signed-off-by", "Reviewed-by", "Change-Id" or "Refs". They are parsed the way
+// "git interpret-trailers" does (see model.ParseTrailers).
+//
+// For each declared trailer key the rule can require or forbid it, restrict how often
+// it appears and check its values against a regular expression. It can also enforce
+// the relative order of keys, and that declared trailers only appear in the final
+// paragraph.
+//
+// Example configuration equivalent to the SignOff and JiraReference body checks:
+//
+//	rule.ValidateTrailers(message, rule.TrailersConfig{
+//	    Specs: []rule.TrailerSpec{rule.JiraRefsTrailer(), rule.SignOffTrailer()},
+//	    Order: []string{"Refs", "Signed-off-by"},
+//	})
+type Trailers struct {
+	config   TrailersConfig
+	trailers model.Trailers
+	errors   []*model.ValidationError
+}
+
+// Name returns the rule name.
+func (rule Trailers) Name() string {
+	return "Trailers"
+}
+
+// Result returns a concise validation result.
+func (rule Trailers) Result() string {
+	if len(rule.errors) > 0 {
+		return "Invalid trailers"
+	}
+
+	return "Trailers valid"
+}
+
+// VerboseResult returns a more detailed explanation for verbose mode.
+func (rule Trailers) VerboseResult() string {
+	if len(rule.errors) > 0 {
+		messages := make([]string, 0, len(rule.errors))
+		for _, err := range rule.errors {
+			messages = append(messages, err.Message)
+		}
+
+		return strings.Join(messages, "; ")
+	}
+
+	if len(rule.trailers.Block) == 0 {
+		return "No trailer block found and none required"
+	}
+
+	keys := make([]string, 0, len(rule.trailers.Block))
+	for _, trailer := range rule.trailers.Block {
+		keys = append(keys, trailer.Key)
+	}
+
+	return fmt.Sprintf("Trailer block on line %d is valid: %s", rule.trailers.StartLine, strings.Join(keys, ", "))
+}
+
+// addError adds a structured validation error.
+func (rule *Trailers) addError(code, message string, context map[string]string) {
+	err := model.NewValidationError("Trailers", code, message)
+
+	// Add any context values
+	for key, value := range context {
+		_ = err.WithContext(key, value)
+	}
+
+	rule.errors = append(rule.errors, err)
+}
+
+// Errors returns validation errors.
+func (rule Trailers) Errors() []*model.ValidationError {
+	return rule.errors
+}
+
+// Help returns a description of how to fix the rule violation.
+func (rule Trailers) Help() string {
+	if len(rule.errors) == 0 {
+		return "No errors to fix"
+	}
+
+	switch rule.errors[0].Code {
+	case "invalid_pattern":
+		return "Fix the trailer 'pattern' setting in your configuration so that it is a valid Go regular expression"
+	case "missing_trailer", "too_few_trailers":
+		return fmt.Sprintf(`Add the missing "%s:" trailer to the last paragraph of your commit message.
+Trailers are "Key: value" lines separated from the body by a blank line, e.g.:
+
+Fix race in cache invalidation
+
+Explain the change here.
+
+%s: <value>
+
+You can add trailers with 'git commit --trailer "%s: <value>"'.`,
+			rule.errors[0].Context["key"], rule.errors[0].Context["key"], rule.errors[0].Context["key"])
+	case "forbidden_trailer":
+		return fmt.Sprintf(`Remove the "%s:" trailer from your commit message, it is not allowed in this project.`,
+			rule.errors[0].Context["key"])
+	case "too_many_trailers":
+		return fmt.Sprintf(`Remove duplicate "%s:" trailers, at most %s are allowed.`,
+			rule.errors[0].Context["key"], rule.errors[0].Context["max"])
+	case "invalid_trailer_value":
+		return fmt.Sprintf(`Correct the value of the "%s:" trailer on line %s so that it matches: %s`,
+			rule.errors[0].Context["key"], rule.errors[0].Context["line"], rule.errors[0].Context["pattern"])
+	case "trailer_order":
+		return fmt.Sprintf("Reorder the trailers in the last paragraph of your commit message: %s",
+			strings.Join(rule.config.Order, ", "))
+	case "trailer_outside_block":
+		return fmt.Sprintf(`Move the "%s:" line on line %s into the trailer block.
+Trailers must all be in the last paragraph of the commit message, separated from the
+body by a blank line, with no other text after them.`,
+			rule.errors[0].Context["key"], rule.errors[0].Context["line"])
+	}
+
+	return "Review the trailers at the end of your commit message according to the project guidelines"
+}
+
+// ValidateTrailers checks the trailer block of a commit message against the configuration.
+//
+// Parameters:
+//   - message: The full commit message
+//   - config: The trailer policies to enforce
+//
+// Returns:
+//   - A Trailers instance with one error per violation
+func ValidateTrailers(message string, config TrailersConfig) *Trailers {
+	rule := &Trailers{
+		config:   config,
+		trailers: model.ParseTrailers(message),
+	}
+
+	for _, spec := range config.Specs {
+		rule.checkSpec(spec)
+	}
+
+	rule.checkOrder()
+
+	if config.RequireFinalParagraph {
+		rule.checkOutside()
+	}
+
+	return rule
+}
+
+// checkSpec validates the trailer block against a single trailer specification.
+func (rule *Trailers) checkSpec(spec TrailerSpec) {
+	var valueRegex *regexp.Regexp
+
+	if spec.Pattern != "" {
+		var err error
+
+		valueRegex, err = regexp.Compile(spec.Pattern)
+		if err != nil {
+			rule.addError(
+				"invalid_pattern",
+				fmt.Sprintf("invalid pattern %q for trailer %s: %s", spec.Pattern, spec.Key, err),
+				map[string]string{
+					"key":     spec.Key,
+					"pattern": spec.Pattern,
+					"error":   err.Error(),
+				},
+			)
+
+			return
+		}
+	}
+
+	var found []model.Trailer
+
+	for _, trailer := range rule.trailers.Block {
+		if strings.EqualFold(trailer.Key, spec.Key) {
+			found = append(found, trailer)
+		}
+	}
+
+	minCount := spec.Min
+	if spec.Required && minCount < 1 {
+		minCount = 1
+	}
+
+	switch {
+	case spec.Forbidden && len(found) > 0:
+		rule.addError(
+			"forbidden_trailer",
+			fmt.Sprintf("trailer %s is not allowed", spec.Key),
+			map[string]string{
+				"key":  spec.Key,
+				"line": strconv.Itoa(found[0].Line),
+			},
+		)
+
+		return
+	case len(found) == 0 && minCount > 0:
+		rule.addError(
+			"missing_trailer",
+			fmt.Sprintf("missing required trailer %s", spec.Key),
+			map[string]string{
+				"key": spec.Key,
+			},
+		)
+
+		return
+	case len(found) < minCount:
+		rule.addError(
+			"too_few_trailers",
+			fmt.Sprintf("trailer %s appears %d times (minimum: %d)", spec.Key, len(found), minCount),
+			map[string]string{
+				"key":   spec.Key,
+				"count": strconv.Itoa(len(found)),
+				"min":   strconv.Itoa(minCount),
+			},
+		)
+	case spec.Max > 0 && len(found) > spec.Max:
+		rule.addError(
+			"too_many_trailers",
+			fmt.Sprintf("trailer %s appears %d times (maximum: %d)", spec.Key, len(found), spec.Max),
+			map[string]string{
+				"key":   spec.Key,
+				"count": strconv.Itoa(len(found)),
+				"max":   strconv.Itoa(spec.Max),
+			},
+		)
+	}
+
+	if valueRegex == nil {
+		return
+	}
+
+	for _, trailer := range found {
+		if !valueRegex.MatchString(trailer.Value) {
+			rule.addError(
+				"invalid_trailer_value",
+				fmt.Sprintf("trailer %s on line %d has invalid value %q", trailer.Key, trailer.Line, trailer.Value),
+				map[string]string{
+					"key":     spec.Key,
+					"value":   trailer.Value,
+					"line":    strconv.Itoa(trailer.Line),
+					"pattern": spec.Pattern,
+				},
+			)
+		}
+	}
+}
+
+// checkOrder validates that ordered keys appear in the configured order.
+func (rule *Trailers) checkOrder() {
+	rank := make(map[string]int, len(rule.config.Order))
+	for index, key := range rule.config.Order {
+		rank[strings.ToLower(key)] = index
+	}
+
+	highest := -1
+	highestKey := ""
+
+	for _, trailer := range rule.trailers.Block {
+		position, ordered := rank[strings.ToLower(trailer.Key)]
+		if !ordered {
+			continue
+		}
+
+		if position < highest {
+			rule.addError(
+				"trailer_order",
+				fmt.Sprintf("trailer %s on line %d must come before %s", trailer.Key, trailer.Line, highestKey),
+				map[string]string{
+					"key":    trailer.Key,
+					"line":   strconv.Itoa(trailer.Line),
+					"before": highestKey,
+				},
+			)
+
+			return
+		}
+
+		highest = position
+		highestKey = trailer.Key
+	}
+}
+
+// checkOutside reports declared trailers that appear before the trailer block.
+func (rule *Trailers) checkOutside() {
+	for _, trailer := range rule.trailers.Outside {
+		if !rule.declared(trailer.Key) {
+			continue
+		}
+
+		rule.addError(
+			"trailer_outside_block",
+			fmt.Sprintf("trailer %s on line %d is not in the final paragraph", trailer.Key, trailer.Line),
+			map[string]string{
+				"key":  trailer.Key,
+				"line": strconv.Itoa(trailer.Line),
+			},
+		)
+	}
+}
+
+// declared reports whether a trailer key has a specification or an order position.
+func (rule *Trailers) declared(key string) bool {
+	for _, spec := range rule.config.Specs {
+		if strings.EqualFold(spec.Key, key) {
+			return true
+		}
+	}
+
+	for _, orderedKey := range rule.config.Order {
+		if strings.EqualFold(orderedKey, key) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+package rule_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/configuration"
+	"github.com/itiquette/gommitlint/internal/rule"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTrailers(t *testing.T) {
+	changeID := "Change-Id: I0123456789abcdef0123456789abcdef01234567"
+
+	gerrit := rule.TrailersConfig{
+		Specs: []rule.TrailerSpec{
+			{Key: "Change-Id", Required: true, Max: 1, Pattern: `^I[0-9a-f]{40}$`},
+			{Key: "Reviewed-by", Min: 2},
+			{Key: "Internal-Only", Forbidden: true},
+		},
+		Order:                 []string{"Change-Id", "Reviewed-by", "Signed-off-by"},
+		RequireFinalParagraph: true,
+	}
+
+	testCases := []struct {
+		name          string
+		message       string
+		config        rule.TrailersConfig
+		expectedCodes []string
+	}{
+		{
+			name:    "Valid trailer block",
+			message: "Fix cache\n\nBody.\n\n" + changeID + "\nReviewed-by: A <a@example.com>\nReviewed-by: B <b@example.com>\nSigned-off-by: C <c@example.com>",
+			config:  gerrit,
+		},
+		{
+			name:          "Missing required trailers",
+			message:       "Fix cache\n\nBody.",
+			config:        gerrit,
+			expectedCodes: []string{"missing_trailer", "missing_trailer"},
+		},
+		{
+			name:          "Too few reviewers",
+			message:       "Fix cache\n\nBody.\n\n" + changeID + "\nReviewed-by: A <a@example.com>",
+			config:        gerrit,
+			expectedCodes: []string{"too_few_trailers"},
+		},
+		{
+			name:          "Too many and invalid Change-Id",
+			message:       "Fix cache\n\n" + changeID + "\nChange-Id: 42\nReviewed-by: A\nReviewed-by: B",
+			config:        gerrit,
+			expectedCodes: []string{"too_many_trailers", "invalid_trailer_value"},
+		},
+		{
+			name:          "Forbidden trailer",
+			message:       "Fix cache\n\n" + changeID + "\nReviewed-by: A\nReviewed-by: B\nInternal-Only: yes",
+			config:        gerrit,
+			expectedCodes: []string{"forbidden_trailer"},
+		},
+		{
+			name:          "Wrong order",
+			message:       "Fix cache\n\nSigned-off-by: C <c@example.com>\n" + changeID + "\nReviewed-by: A\nReviewed-by: B",
+			config:        gerrit,
+			expectedCodes: []string{"trailer_order"},
+		},
+		{
+			name:          "Declared trailer outside the final paragraph",
+			message:       "Fix cache\n\nReviewed-by: A\n\nBody.\n\n" + changeID + "\nReviewed-by: A\nReviewed-by: B",
+			config:        gerrit,
+			expectedCodes: []string{"trailer_outside_block"},
+		},
+		{
+			name:    "Undeclared trailer-like line in the body",
+			message: "Fix cache\n\nNote: this is prose.\n\n" + changeID + "\nReviewed-by: A\nReviewed-by: B",
+			config:  gerrit,
+		},
+		{
+			name:          "Invalid pattern",
+			message:       "Fix cache\n\nBody.",
+			config:        rule.TrailersConfig{Specs: []rule.TrailerSpec{{Key: "Refs", Pattern: "("}}},
+			expectedCodes: []string{"invalid_pattern"},
+		},
+		{
+			name:    "Keys are case insensitive",
+			message: "Fix cache\n\nchange-id: I0123456789abcdef0123456789abcdef01234567",
+			config:  rule.TrailersConfig{Specs: []rule.TrailerSpec{{Key: "Change-Id", Required: true}}},
+		},
+	}
+
+	for _, tabletest := range testCases {
+		t.Run(tabletest.name, func(t *testing.T) {
+			result := rule.ValidateTrailers(tabletest.message, tabletest.config)
+
+			assert.Equal(t, "Trailers", result.Name())
+
+			codes := make([]string, 0, len(result.Errors()))
+			for _, err := range result.Errors() {
+				codes = append(codes, err.Code)
+			}
+
+			if len(tabletest.expectedCodes) == 0 {
+				assert.Empty(t, codes)
+				assert.Equal(t, "Trailers valid", result.Result())
+				assert.Equal(t, "No errors to fix", result.Help())
+
+				return
+			}
+
+			assert.Equal(t, tabletest.expectedCodes, codes)
+			assert.Equal(t, "Invalid trailers", result.Result())
+			assert.NotEqual(t, "No errors to fix", result.Help())
+			assert.NotEmpty(t, result.VerboseResult())
+		})
+	}
+}
+
+// TestTrailersExpressSignOffAndJira checks that the built-in sign-off and Jira "Refs:"
+// checks can be expressed as trailer specifications.
+func TestTrailersExpressSignOffAndJira(t *testing.T) {
+	config := rule.TrailersConfig{
+		Specs: []rule.TrailerSpec{rule.JiraRefsTrailer(), rule.SignOffTrailer()},
+		Order: []string{"Refs", "Signed-off-by"},
+	}
+
+	jira := &configuration.JiraRule{BodyRef: true}
+
+	testCases := []struct {
+		name    string
+		body    string
+		isValid bool
+	}{
+		{name: "Valid", body: "Body.\n\nRefs: PROJ-1, PROJ-2\nSigned-off-by: Jane Doe <jane@example.com>", isValid: true},
+		{name: "Missing sign-off", body: "Body.\n\nRefs: PROJ-1", isValid: false},
+		{name: "Malformed sign-off", body: "Body.\n\nRefs: PROJ-1\nSigned-off-by: Jane Doe (jane@example.com)", isValid: false},
+		{name: "Malformed refs", body: "Body.\n\nRefs: proj-1\nSigned-off-by: Jane Doe <jane@example.com>", isValid: false},
+		{name: "Refs after sign-off", body: "Body.\n\nSigned-off-by: Jane Doe <jane@example.com>\nRefs: PROJ-1", isValid: false},
+	}
+
+	for _, tabletest := range testCases {
+		t.Run(tabletest.name, func(t *testing.T) {
+			builtin := len(rule.ValidateSignOff(tabletest.body).Errors()) == 0 &&
+				len(rule.ValidateJiraReference("PROJ-1 fix cache", tabletest.body, jira, false).Errors()) == 0
+			require.Equal(t, tabletest.isValid, builtin, "built-in rules")
+
+			trailers := rule.ValidateTrailers("Fix cache\n\n"+tabletest.body, config)
+			assert.Equal(t, tabletest.isValid, len(trailers.Errors()) == 0, "trailer rule: %v", trailers.Errors())
+		})
+	}
+}
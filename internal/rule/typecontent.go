@@ -0,0 +1,251 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+
+	"github.com/itiquette/gommitlint/internal/model"
+)
+
+// DefaultChangeCategories maps the conventional types that describe a kind of file to the
+// path patterns of those files.
+var DefaultChangeCategories = map[string][]string{
+	"docs":  {"docs/", "doc/", "*.md", "*.adoc", "*.rst", "LICENSE*"},
+	"test":  {"*_test.go", "*.test.*", "*.spec.*", "**/test/", "**/tests/", "**/testdata/"},
+	"ci":    {".github/workflows/", ".gitlab-ci.yml", ".circleci/", "Jenkinsfile", ".travis.yml", "azure-pipelines.yml"},
+	"build": {"Makefile", "Dockerfile", "go.mod", "go.sum", "package.json", "package-lock.json", "*.gradle", "pom.xml"},
+}
+
+// DefaultExemptTypes are the types that may change any files.
+var DefaultExemptTypes = []string{"chore", "revert"}
+
+// TypeContentConfig provides configuration for the TypeContent rule.
+type TypeContentConfig struct {
+	// Categories maps a type to the path patterns of the files it describes
+	Categories map[string][]string
+
+	// ExemptTypes lists types that may change any files
+	ExemptTypes []string
+}
+
+// DefaultTypeContentConfig returns the default configuration.
+func DefaultTypeContentConfig() TypeContentConfig {
+	return TypeContentConfig{
+		Categories:  maps.Clone(DefaultChangeCategories),
+		ExemptTypes: DefaultExemptTypes,
+	}
+}
+
+// TypeContentOption configures a TypeContentConfig.
+type TypeContentOption func(*TypeContentConfig)
+
+// WithTypeCategories adds categories, replacing the default patterns of the same type.
+func WithTypeCategories(categories map[string][]string) TypeContentOption {
+	return func(c *TypeContentConfig) {
+		maps.Copy(c.Categories, categories)
+	}
+}
+
+// WithExemptTypes replaces the default exempt types.
+func WithExemptTypes(types []string) TypeContentOption {
+	return func(c *TypeContentConfig) {
+		if len(types) > 0 {
+			c.ExemptTypes = types
+		}
+	}
+}
+
+// TypeContent checks that the type of a conventional commit matches the files it changes.
+//
+// Types such as "docs", "test", "ci" and "build" describe a kind of file, and changelogs
+// and release tools rely on them: a "docs" commit does not trigger a release and a "feat"
+// commit does. The changed files are classified by path patterns, and the rule checks that:
+//
+//   - a commit with a category type only changes files of that category, so that "docs"
+//     does not hide code changes
+//   - a commit with any other type does not only change files of a single category, so
+//     that a README edit is not released as "feat"
+//
+// Exempt types, by default "chore" and "revert", may change any files.
+//
+// Examples:
+//
+//   - "docs: explain setup" changing README.md would pass
+//   - "docs: explain setup" changing README.md and main.go would fail
+//   - "feat: explain setup" changing only README.md would fail, suggesting "docs"
+type TypeContent struct {
+	commitType string
+	category   string
+	errors     []*model.ValidationError
+}
+
+// Name returns the rule name.
+func (rule TypeContent) Name() string {
+	return "TypeContent"
+}
+
+// Result returns a concise validation result.
+func (rule TypeContent) Result() string {
+	if len(rule.errors) > 0 {
+		return "Type does not match changed files"
+	}
+
+	return "Type matches changed files"
+}
+
+// VerboseResult returns a more detailed explanation for verbose mode.
+func (rule TypeContent) VerboseResult() string {
+	if len(rule.errors) > 0 {
+		switch rule.errors[0].Code {
+		case "files_outside_type":
+			return fmt.Sprintf("Type '%s' may only change %s files, but the commit also changes %s",
+				rule.commitType, rule.commitType, rule.errors[0].Context["paths"])
+		case "type_mismatch":
+			return fmt.Sprintf("Type '%s' is used for a commit that only changes %s files. Suggested type: '%s'",
+				rule.commitType, rule.category, rule.errors[0].Context["suggested_type"])
+		default:
+			return rule.errors[0].Error()
+		}
+	}
+
+	if rule.category != "" {
+		return fmt.Sprintf("Commit only changes %s files", rule.category)
+	}
+
+	return fmt.Sprintf("Type '%s' matches the changed files", rule.commitType)
+}
+
+// addError adds a structured validation error.
+func (rule *TypeContent) addError(code, message string, context map[string]string) {
+	err := model.NewValidationError("TypeContent", code, message)
+
+	// Add any context values
+	for key, value := range context {
+		_ = err.WithContext(key, value)
+	}
+
+	rule.errors = append(rule.errors, err)
+}
+
+// Errors returns validation errors.
+func (rule TypeContent) Errors() []*model.ValidationError {
+	return rule.errors
+}
+
+// Help returns a description of how to fix the rule violation.
+func (rule TypeContent) Help() string {
+	if len(rule.errors) == 0 {
+		return "No errors to fix"
+	}
+
+	switch rule.errors[0].Code {
+	case "files_outside_type":
+		return fmt.Sprintf(`A '%s' commit may only change %s files. These files are not:
+%s
+
+Use the type that describes the main change, e.g. 'feat' or 'fix', or split the
+commit. The patterns of each type are configured in
+'conventional-commit.type-content.categories'.`,
+			rule.commitType, rule.commitType, rule.errors[0].Context["paths"])
+
+	case "type_mismatch":
+		return fmt.Sprintf(`The commit only changes %s files. Use the matching type:
+
+%s: description`, rule.category, rule.errors[0].Context["suggested_type"])
+	}
+
+	return rule.errors[0].Message
+}
+
+// ValidateTypeContent checks that the type of a conventional commit matches the changed paths.
+//
+// Parameters:
+//   - commit: The parsed conventional commit, nil if the message is not a conventional commit
+//   - changedPaths: The paths the commit changes compared to its first parent
+//   - opts: Options overriding DefaultTypeContentConfig
+//
+// Returns:
+//   - A TypeContent instance with validation results
+func ValidateTypeContent(commit *model.ConventionalCommit, changedPaths []string, opts ...TypeContentOption) *TypeContent {
+	config := DefaultTypeContentConfig()
+	for _, opt := range opts {
+		opt(&config)
+	}
+
+	rule := &TypeContent{}
+	if commit == nil || len(changedPaths) == 0 {
+		return rule
+	}
+
+	rule.commitType = commit.Type
+
+	if slices.Contains(config.ExemptTypes, commit.Type) {
+		return rule
+	}
+
+	if patterns, ok := config.Categories[commit.Type]; ok {
+		var outside []string
+
+		for _, changedPath := range changedPaths {
+			if !matchesAnyPath(patterns, changedPath) {
+				outside = append(outside, changedPath)
+			}
+		}
+
+		if len(outside) == 0 {
+			rule.category = commit.Type
+
+			return rule
+		}
+
+		rule.addError(
+			"files_outside_type",
+			fmt.Sprintf("%s commit changes files outside %s paths: %s", commit.Type, commit.Type, listPaths(outside)),
+			map[string]string{
+				"type":  commit.Type,
+				"paths": listPaths(outside),
+			},
+		)
+
+		return rule
+	}
+
+	rule.category = changeCategory(config.Categories, changedPaths)
+	if rule.category == "" {
+		return rule
+	}
+
+	rule.addError(
+		"type_mismatch",
+		fmt.Sprintf("%s commit only changes %s files", commit.Type, rule.category),
+		map[string]string{
+			"type":           commit.Type,
+			"suggested_type": rule.category,
+		},
+	)
+
+	return rule
+}
+
+// changeCategory returns the category all paths belong to, or "" if there is none.
+// If several categories cover all paths, the first in sorted order is returned.
+func changeCategory(categories map[string][]string, paths []string) string {
+	names := slices.Collect(maps.Keys(categories))
+	sort.Strings(names)
+
+	for _, name := range names {
+		if !slices.ContainsFunc(paths, func(changedPath string) bool {
+			return !matchesAnyPath(categories[name], changedPath)
+		}) {
+			return name
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,120 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+
+package rule_test
+
+import (
+	"testing"
+
+	"github.com/itiquette/gommitlint/internal/model"
+	"github.com/itiquette/gommitlint/internal/rule"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateTypeContent(t *testing.T) {
+	tests := []struct {
+		name          string
+		subject       string
+		paths         []string
+		opts          []rule.TypeContentOption
+		wantCode      string
+		wantSuggested string
+	}{
+		{
+			name:    "not a conventional commit",
+			subject: "Explain setup",
+			paths:   []string{"README.md"},
+		},
+		{
+			name:    "docs only changes docs",
+			subject: "docs: explain setup",
+			paths:   []string{"README.md", "docs/setup.adoc"},
+		},
+		{
+			name:     "docs hides code changes",
+			subject:  "docs: explain setup",
+			paths:    []string{"README.md", "cmd/root.go"},
+			wantCode: "files_outside_type",
+		},
+		{
+			name:    "test only changes tests",
+			subject: "test: cover parser",
+			paths:   []string{"internal/model/conventional_test.go", "internal/model/testdata/msg.txt"},
+		},
+		{
+			name:     "ci changes code",
+			subject:  "ci: run linter",
+			paths:    []string{".github/workflows/lint.yml", "Makefile"},
+			wantCode: "files_outside_type",
+		},
+		{
+			name:          "feat only changes docs",
+			subject:       "feat: explain setup",
+			paths:         []string{"README.md"},
+			wantCode:      "type_mismatch",
+			wantSuggested: "docs",
+		},
+		{
+			name:    "feat changes code and docs",
+			subject: "feat(api): add login",
+			paths:   []string{"README.md", "api/login.go"},
+		},
+		{
+			name:    "feat changes docs and tests",
+			subject: "feat: add examples",
+			paths:   []string{"README.md", "example_test.go"},
+		},
+		{
+			name:    "exempt type",
+			subject: "chore: bump modules",
+			paths:   []string{"go.mod", "go.sum"},
+		},
+		{
+			name:          "custom exempt types replace the defaults",
+			subject:       "chore: bump modules",
+			paths:         []string{"go.mod", "go.sum"},
+			opts:          []rule.TypeContentOption{rule.WithExemptTypes([]string{"revert"})},
+			wantCode:      "type_mismatch",
+			wantSuggested: "build",
+		},
+		{
+			name:    "custom category",
+			subject: "docs: update handbook",
+			paths:   []string{"handbook/intro.txt"},
+			opts: []rule.TypeContentOption{rule.WithTypeCategories(map[string][]string{
+				"docs": {"handbook/"},
+			})},
+		},
+		{
+			name:    "no changed files",
+			subject: "docs: explain setup",
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			result := rule.ValidateTypeContent(model.ParseConventionalCommit(tabletest.subject), tabletest.paths, tabletest.opts...)
+
+			if tabletest.wantCode == "" {
+				require.Empty(t, result.Errors())
+				require.Equal(t, "Type matches changed files", result.Result())
+
+				return
+			}
+
+			require.Len(t, result.Errors(), 1)
+			require.Equal(t, tabletest.wantCode, result.Errors()[0].Code)
+			require.Equal(t, tabletest.wantSuggested, result.Errors()[0].Context["suggested_type"])
+			require.Equal(t, "Type does not match changed files", result.Result())
+			require.NotEqual(t, "No errors to fix", result.Help())
+		})
+	}
+}
+
+func TestTypeContentDefaultsAreNotModified(t *testing.T) {
+	rule.ValidateTypeContent(model.ParseConventionalCommit("docs: x"), []string{"x.txt"},
+		rule.WithTypeCategories(map[string][]string{"docs": {"x.txt"}}))
+
+	require.NotContains(t, rule.DefaultChangeCategories["docs"], "x.txt")
+}
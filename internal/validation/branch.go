@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+package validation
+
+import (
+	"fmt"
+
+	"github.com/itiquette/gommitlint/internal/model"
+	"github.com/itiquette/gommitlint/internal/rule"
+)
+
+// ValidateBranch validates the name of the branch option and returns its rules.
+// With match-commit-keys the commits to validate are read to cross-check their Jira keys.
+func (v *Validator) ValidateBranch() (*model.CommitRules, error) {
+	branchRules := model.NewCommitRules()
+
+	v.ensureDefaultValues()
+
+	branch := v.config.Branch
+
+	opts := []rule.BranchNameOption{
+		rule.WithBranchPatterns(branch.Patterns),
+		rule.WithExemptBranches(branch.Exempt),
+		rule.WithBranchJiraKeyRequired(branch.RequireJiraKey),
+	}
+
+	if branch.MatchCommitKeys {
+		commits, err := v.GetCommitsToValidate()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get commits: %w", err)
+		}
+
+		subjects := make([]string, 0, len(commits))
+		for _, commitInfo := range commits {
+			subjects = append(subjects, commitInfo.Subject)
+		}
+
+		opts = append(opts, rule.WithCommitJiraKeys(subjects))
+	}
+
+	branchNameRule := rule.ValidateBranchName(v.options.Branch, opts...)
+	branchRules.Add(branchNameRule)
+
+	return branchRules, nil
+}
@@ -28,16 +28,7 @@ func (v *Validator) getCommitInfosFromFile() ([]model.CommitInfo, error) {
 		return nil, fmt.Errorf("failed to read commit message file: %w", err)
 	}
 
-	subject, body := model.SplitCommitMessage(string(contents))
-	commits := []model.CommitInfo{{
-		Message:   string(contents),
-		Subject:   subject,
-		Body:      body,
-		Signature: "",
-		RawCommit: nil,
-	}}
-
-	return commits, nil
+	return []model.CommitInfo{model.NewCommitInfo(string(contents), nil)}, nil
 }
 
 func (v *Validator) getCommitInfosFromRange() ([]model.CommitInfo, error) {
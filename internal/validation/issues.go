@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+package validation
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/issuetracker"
+	"github.com/itiquette/gommitlint/internal/rule"
+)
+
+// issueStatusOptions returns the IssueStatus options for the trackers with an API,
+// or nil if no tracker has one.
+func (v *Validator) issueStatusOptions() []rule.IssueStatusOption {
+	var opts []rule.IssueStatusOption
+
+	for name, tracker := range v.config.IssueReference.Trackers {
+		if tracker.API != nil {
+			opts = append(opts,
+				rule.WithAllowedStatuses(name, tracker.API.Statuses),
+				rule.WithForbiddenStatuses(name, tracker.API.ForbiddenStatuses))
+		}
+	}
+
+	if opts == nil {
+		return nil
+	}
+
+	return append(opts,
+		rule.WithIssueLookup(v.lookupIssue),
+		rule.WithUnreachableCheck(func(err error) bool {
+			return errors.Is(err, issuetracker.ErrUnreachable)
+		}))
+}
+
+// verifiableIssueRefs returns the references of trackers with an API.
+func (v *Validator) verifiableIssueRefs(refs []rule.IssueRef) []rule.IssueRef {
+	var verifiable []rule.IssueRef
+
+	for _, ref := range refs {
+		if v.config.IssueReference.Trackers[ref.Tracker].API != nil {
+			verifiable = append(verifiable, ref)
+		}
+	}
+
+	return verifiable
+}
+
+// lookupIssue looks up a referenced issue with the API of its tracker.
+func (v *Validator) lookupIssue(ref rule.IssueRef) (rule.IssueState, error) {
+	client, err := v.issueClient(ref.Tracker)
+	if err != nil {
+		return rule.IssueState{}, err
+	}
+
+	issue, err := client.Lookup(context.Background(), issuetracker.Reference{
+		Project:      ref.Project,
+		ID:           ref.ID,
+		MergeRequest: strings.Contains(ref.Text, "!"),
+	})
+	if err != nil {
+		return rule.IssueState{}, err
+	}
+
+	return rule.IssueState{Exists: issue.Exists, Status: issue.Status}, nil
+}
+
+// issueClient returns the API client of a tracker, creating it on first use.
+// The clients share one cache, so that it is read once per run. A client that cannot
+// be created is not tried again.
+func (v *Validator) issueClient(tracker string) (*issuetracker.Client, error) {
+	if client, found := v.issueClients[tracker]; found {
+		return client, nil
+	}
+
+	if err, found := v.issueClientErrors[tracker]; found {
+		return nil, err
+	}
+
+	issueReference := v.config.IssueReference
+	if v.issueCache == nil {
+		cacheFile := issueReference.CacheFile
+		if cacheFile == "" {
+			cacheFile = issuetracker.DefaultCachePath()
+		}
+
+		v.issueCache = issuetracker.NewCache(cacheFile, issueReference.CacheTTL)
+	}
+
+	api := issueReference.Trackers[tracker].API
+
+	kind := api.Kind
+	if kind == "" {
+		kind = tracker
+	}
+
+	timeout := api.Timeout
+	if timeout <= 0 {
+		timeout = issuetracker.DefaultTimeout
+	}
+
+	opts := []issuetracker.Option{
+		issuetracker.WithDefaultProject(api.Project),
+		issuetracker.WithHTTPClient(&http.Client{Timeout: timeout}),
+		issuetracker.WithCache(v.issueCache),
+	}
+
+	if api.TokenEnv != "" {
+		opts = append(opts, issuetracker.WithToken(os.Getenv(api.TokenEnv)))
+	}
+
+	client, err := issuetracker.NewClient(kind, api.URL, opts...)
+	if err != nil {
+		if v.issueClientErrors == nil {
+			v.issueClientErrors = make(map[string]error)
+		}
+
+		v.issueClientErrors[tracker] = err
+
+		return nil, err
+	}
+
+	if v.issueClients == nil {
+		v.issueClients = make(map[string]*issuetracker.Client)
+	}
+
+	v.issueClients[tracker] = client
+
+	return client, nil
+}
@@ -4,7 +4,13 @@
 package validation
 
 import (
+	"fmt"
+	"maps"
+	"slices"
+
 	"github.com/itiquette/gommitlint/internal/configuration"
+	"github.com/itiquette/gommitlint/internal/expression"
+	gitService "github.com/itiquette/gommitlint/internal/git"
 	"github.com/itiquette/gommitlint/internal/model"
 	"github.com/itiquette/gommitlint/internal/rule"
 	"github.com/itiquette/gommitlint/internal/rule/signedidentityrule"
@@ -20,6 +26,8 @@ var (
 	DefaultSubjectImperativeRequired = true
 	DefaultSignOffRequired           = true
 	DefaultOneCommitMax              = true
+	DefaultTagAnnotatedRequired      = true
+	DefaultTagMessageRequired        = true
 )
 
 var DefaultConventionalTypes = []string{
@@ -35,15 +43,20 @@ func boolPtr(b bool) *bool {
 func (v *Validator) checkValidity(commitRules *model.CommitRules, commitInfo model.CommitInfo) {
 	v.ensureDefaultValues()
 
+	when := v.newWhenContext(commitInfo)
+
+	// The merge policy also applies to the merge commits the other rules ignore
+	v.checkMergePolicy(commitRules, commitInfo, when)
+
 	if *v.config.IgnoreMergeCommits && commitInfo.IsMergeCommit {
 		//fmt.Printf("Ignoring merge commit")
 		return
 	}
 
-	v.checkSubjectRules(commitRules, commitInfo)
-	v.checkSignatureRules(commitRules, commitInfo)
-	v.checkConventionalRules(commitRules, commitInfo)
-	v.checkAdditionalRules(commitRules, commitInfo)
+	v.checkSubjectRules(commitRules, commitInfo, when)
+	v.checkSignatureRules(commitRules, commitInfo, when)
+	v.checkConventionalRules(commitRules, commitInfo, when)
+	v.checkAdditionalRules(commitRules, commitInfo, when)
 }
 
 // ensureDefaultValues ensures all configuration values have appropriate defaults.
@@ -103,15 +116,33 @@ func (v *Validator) ensureDefaultValues() {
 	if v.config.IgnoreMergeCommits == nil {
 		v.config.IgnoreMergeCommits = boolPtr(true)
 	}
+
+	// Tag defaults
+	if v.config.Tag == nil {
+		v.config.Tag = &configuration.TagRule{}
+	}
+
+	if v.config.Tag.Annotated == nil {
+		v.config.Tag.Annotated = boolPtr(DefaultTagAnnotatedRequired)
+	}
+
+	if v.config.Tag.MessageRequired == nil {
+		v.config.Tag.MessageRequired = boolPtr(DefaultTagMessageRequired)
+	}
+
+	// Branch defaults
+	if v.config.Branch == nil {
+		v.config.Branch = &configuration.BranchRule{}
+	}
 }
 
-func (v *Validator) checkSubjectRules(report *model.CommitRules, commitInfo model.CommitInfo) {
-	if v.config.Subject == nil {
+func (v *Validator) checkSubjectRules(report *model.CommitRules, commitInfo model.CommitInfo, when *whenContext) {
+	if v.config.Subject == nil || !when.active(v.config.Subject.When) || v.isSkippedRevert(commitInfo, when) {
 		return
 	}
 
 	subject := v.config.Subject
-	isConventional := v.config.ConventionalCommit.Required
+	isConventional := v.config.ConventionalCommit.Required && when.active(v.config.ConventionalCommit.When)
 
 	subjectLengthRule := rule.ValidateSubjectLength(commitInfo.Subject, subject.MaxLength)
 	report.Add(subjectLengthRule)
@@ -127,48 +158,583 @@ func (v *Validator) checkSubjectRules(report *model.CommitRules, commitInfo mode
 	subjectSuffixRule := rule.ValidateSubjectSuffix(commitInfo.Subject, subject.InvalidSuffixes)
 	report.Add(subjectSuffixRule)
 
-	if subject.Jira.Required {
+	// With the jira tracker of issue-reference the keys are checked by the IssueReference rule
+	if subject.Jira.Required && !v.hasIssueTracker("jira") {
 		jiraReferenceRule := rule.ValidateJiraReference(commitInfo.Subject, commitInfo.Body, subject.Jira, isConventional)
 		report.Add(jiraReferenceRule)
 	}
 }
 
-func (v *Validator) checkSignatureRules(report *model.CommitRules, commitInfo model.CommitInfo) {
-	if *v.config.SignOffRequired {
-		signOffRule := rule.ValidateSignOff(commitInfo.Body)
+func (v *Validator) checkSignatureRules(report *model.CommitRules, commitInfo model.CommitInfo, when *whenContext) {
+	if *v.config.SignOffRequired && (v.config.SignOffIdentity == nil || when.active(v.config.SignOffIdentity.When)) {
+		signOffRule := rule.ValidateSignOff(commitInfo.Body, v.signOffOptions(commitInfo)...)
 		report.Add(signOffRule)
 	}
 
-	if v.config.Signature.Required {
+	if v.config.Signature.Required && when.active(v.config.Signature.When) {
 		signatureRule := rule.ValidateSignature(commitInfo.Signature)
 		report.Add(signatureRule)
 
 		if v.config.Signature.Identity != nil {
-			signedIdentityRule := signedidentityrule.VerifySignatureIdentity(commitInfo.RawCommit, commitInfo.Signature, v.config.Signature.Identity.PublicKeyURI)
+			identity := v.config.Signature.Identity
+
+			signedIdentityRule := signedidentityrule.VerifySignatureIdentity(commitInfo.RawCommit, commitInfo.Signature, identity.PublicKeyURI, v.signedIdentityOptions()...)
 			report.Add(signedIdentityRule)
 		}
 	}
 }
 
-func (v *Validator) checkConventionalRules(report *model.CommitRules, commitInfo model.CommitInfo) {
-	if v.config.ConventionalCommit.Required {
+// signOffOptions converts the sign-off identity configuration into SignOff options.
+// Identities come from the commit, or from git for messages read from a file.
+func (v *Validator) signOffOptions(commitInfo model.CommitInfo) []rule.SignOffOption {
+	identity := v.config.SignOffIdentity
+	if identity == nil {
+		return nil
+	}
+
+	ignoreCase := identity.IgnoreCase == nil || *identity.IgnoreCase
+	signOffOpts := []rule.SignOffOption{
+		rule.WithEmailNormalization(ignoreCase, identity.IgnorePlusTag),
+		rule.WithCoAuthorSignOffs(identity.CoAuthors),
+	}
+
+	if identity.Author {
+		if author, err := v.commitAuthor(commitInfo); err == nil {
+			signOffOpts = append(signOffOpts, rule.WithSignOffAuthor(author.Name, author.Email))
+		}
+	}
+
+	if identity.Committer {
+		if committer, err := v.commitCommitter(commitInfo); err == nil {
+			signOffOpts = append(signOffOpts, rule.WithSignOffCommitter(committer.Name, committer.Email))
+		}
+	}
+
+	return signOffOpts
+}
+
+// commitAuthor returns the author of the commit, or for messages read from a file,
+// the author git would record for a new commit.
+func (v *Validator) commitAuthor(commitInfo model.CommitInfo) (model.Identity, error) {
+	if commitInfo.RawCommit != nil {
+		return model.Identity{Name: commitInfo.RawCommit.Author.Name, Email: commitInfo.RawCommit.Author.Email}, nil
+	}
+
+	return gitService.AuthorIdent(v.repo)
+}
+
+// commitCommitter returns the committer of the commit, or for messages read from a file,
+// the committer git would record for a new commit.
+func (v *Validator) commitCommitter(commitInfo model.CommitInfo) (model.Identity, error) {
+	if commitInfo.RawCommit != nil {
+		return model.Identity{Name: commitInfo.RawCommit.Committer.Name, Email: commitInfo.RawCommit.Committer.Email}, nil
+	}
+
+	return gitService.CommitterIdent(v.repo)
+}
+
+// signedIdentityOptions converts the identity configuration into SignedIdentity options.
+func (v *Validator) signedIdentityOptions() []signedidentityrule.Option {
+	identity := v.config.Signature.Identity
+
+	var identityOpts []signedidentityrule.Option
+	if identity.KeyPolicy != nil {
+		identityOpts = append(identityOpts, signedidentityrule.WithKeyPolicy(signedidentityrule.KeyPolicy{
+			MinRSABits:            identity.KeyPolicy.MinRSABits,
+			MinECBits:             identity.KeyPolicy.MinECBits,
+			AllowedAlgorithms:     identity.KeyPolicy.AllowedAlgorithms,
+			AllowedHashAlgorithms: identity.KeyPolicy.AllowedHashAlgorithms,
+		}))
+	}
+
+	if identity.CABundle != "" {
+		identityOpts = append(identityOpts, signedidentityrule.WithCABundle(identity.CABundle))
+	}
+
+	if identity.Sigstore != nil {
+		sigstoreConfig := signedidentityrule.SigstoreConfig{
+			FulcioRoots:     identity.Sigstore.FulcioRoots,
+			RekorPublicKeys: identity.Sigstore.RekorPublicKeys,
+		}
+
+		for _, allowed := range identity.Sigstore.Identities {
+			sigstoreConfig.Identities = append(sigstoreConfig.Identities, signedidentityrule.SigstoreIdentity{
+				Subject: allowed.Subject,
+				Issuer:  allowed.Issuer,
+			})
+		}
+
+		identityOpts = append(identityOpts, signedidentityrule.WithSigstore(sigstoreConfig))
+	}
+
+	return identityOpts
+}
+
+func (v *Validator) checkConventionalRules(report *model.CommitRules, commitInfo model.CommitInfo, when *whenContext) {
+	if v.config.ConventionalCommit.Required && when.active(v.config.ConventionalCommit.When) && !v.isSkippedRevert(commitInfo, when) {
 		conv := v.config.ConventionalCommit
-		ccRule := rule.ValidateConventionalCommit(commitInfo.Subject, conv.Types, conv.Scopes, conv.MaxDescriptionLength)
+		ccRule := rule.ValidateConventionalCommit(commitInfo.Subject, conv.Types, conv.Scopes, conv.MaxDescriptionLength,
+			rule.WithConventionalMessage(commitInfo.Conventional))
 		report.Add(ccRule)
+
+		if breaking := conv.BreakingChange; breaking != nil {
+			breakingChangeRule := rule.ValidateBreakingChange(commitInfo.Subject, commitInfo.Body,
+				rule.WithBreakingChangeMessage(commitInfo.Conventional),
+				rule.WithBreakingFooterRequired(breaking.RequireFooter),
+				rule.WithBreakingMarkerRequired(breaking.RequireMarker),
+				rule.WithBreakingTypes(breaking.Types),
+				rule.WithMinBreakingFooterLength(breaking.MinFooterLength))
+			report.Add(breakingChangeRule)
+		}
+
+		if scopePaths := conv.ScopePaths; scopePaths != nil {
+			if paths, known := when.changedPaths(); known {
+				scopePathsRule := rule.ValidateScopePaths(commitInfo.Conventional, paths,
+					rule.WithScopePaths(scopePaths.Scopes),
+					rule.WithIgnoredPaths(scopePaths.Ignore))
+				report.Add(scopePathsRule)
+			}
+		}
+
+		if typeContent := conv.TypeContent; typeContent != nil {
+			if paths, known := when.changedPaths(); known {
+				typeContentRule := rule.ValidateTypeContent(commitInfo.Conventional, paths,
+					rule.WithTypeCategories(typeContent.Categories),
+					rule.WithExemptTypes(typeContent.Exempt))
+				report.Add(typeContentRule)
+			}
+		}
 	}
 }
 
-func (v *Validator) checkAdditionalRules(report *model.CommitRules, commitInfo model.CommitInfo) {
-	spellRule := rule.ValidateSpelling(commitInfo.Message, v.config.SpellCheck.Locale)
-	report.Add(spellRule)
+func (v *Validator) checkAdditionalRules(report *model.CommitRules, commitInfo model.CommitInfo, when *whenContext) {
+	if v.config.Autosquash != nil && when.active(v.config.Autosquash.When) {
+		autosquash := v.config.Autosquash
+		allowed := autosquash.AllowOnFeatureBranches && v.options.BaseBranch == ""
+
+		autosquashRule := rule.ValidateAutosquashLeftover(commitInfo.Subject,
+			rule.WithDenyPatterns(autosquash.Deny),
+			rule.WithLeftoversAllowed(allowed))
+		report.Add(autosquashRule)
+	}
+
+	if when.active(v.config.SpellCheck.When) {
+		spellRule := rule.ValidateSpelling(commitInfo.Message, v.config.SpellCheck.Locale)
+		report.Add(spellRule)
+	}
+
+	if v.config.SensitiveContent != nil && when.active(v.config.SensitiveContent.When) {
+		sensitiveContent := v.config.SensitiveContent
+		sensitiveContentRule := rule.ValidateSensitiveContent(commitInfo.Message,
+			rule.WithSecretPatterns(sensitiveContent.Patterns),
+			rule.WithDisabledDetectors(sensitiveContent.Disable),
+			rule.WithMinEntropy(sensitiveContent.MinEntropy),
+			rule.WithAllowedSecrets(sensitiveContent.Allow))
+		report.Add(sensitiveContentRule)
+	}
+
+	if v.config.BannedTerms != nil && when.active(v.config.BannedTerms.When) {
+		bannedTermsRule := rule.ValidateBannedTerms(commitInfo.Message, v.bannedTermsOptions()...)
+		report.Add(bannedTermsRule)
+	}
 
 	if *v.config.NCommitsAhead {
 		commitsAhead := rule.ValidateNumberOfCommits(v.repo, v.options.CommitRef)
 		report.Add(commitsAhead)
 	}
 
-	if v.config.Body.Required {
+	if v.config.CommitSize != nil && when.active(v.config.CommitSize.When) {
+		commitSize := v.config.CommitSize
+		options := gitService.ChangeOptions{
+			Lines:  commitSize.MaxLines > 0,
+			Binary: commitSize.ForbidBinary,
+			Exclude: func(changedPath string) bool {
+				return slices.ContainsFunc(commitSize.Exclude, func(pattern string) bool {
+					return gitService.MatchPath(pattern, changedPath)
+				})
+			},
+		}
+
+		if changes, known := when.fileChanges(options); known {
+			commitSizeRule := rule.ValidateCommitSize(changes,
+				rule.WithMaxFiles(commitSize.MaxFiles),
+				rule.WithMaxLines(commitSize.MaxLines),
+				rule.WithMaxFileSize(commitSize.MaxFileSize),
+				rule.WithBinaryForbidden(commitSize.ForbidBinary),
+				rule.WithSizeExclusions(commitSize.Exclude))
+			report.Add(commitSizeRule)
+		}
+	}
+
+	if v.config.Body.Required && when.active(v.config.Body.When) {
 		commitBodyRule := rule.ValidateCommitBody(commitInfo.Message)
 		report.Add(commitBodyRule)
 	}
+
+	if v.config.Trailers != nil && when.active(v.config.Trailers.When) {
+		trailersRule := rule.ValidateTrailers(commitInfo.Message, v.trailersConfig())
+		report.Add(trailersRule)
+	}
+
+	if v.config.Body.MaxLineLength > 0 && when.active(v.config.Body.When) {
+		bodyLineLengthRule := rule.ValidateBodyLineLength(commitInfo.Message, v.bodyLineLengthOptions()...)
+		report.Add(bodyLineLengthRule)
+	}
+
+	if v.config.CommitIdentity != nil && when.active(v.config.CommitIdentity.When) {
+		if author, err := v.commitAuthor(commitInfo); err == nil {
+			committer, err := v.commitCommitter(commitInfo)
+			if err != nil {
+				committer = author
+			}
+
+			identityRule := rule.ValidateCommitIdentity(
+				author,
+				committer,
+				commitInfo.IsMergeCommit,
+				v.commitIdentityOptions()...,
+			)
+			report.Add(identityRule)
+		}
+	}
+
+	if v.config.Revert != nil && when.active(v.config.Revert.When) {
+		var revertOpts []rule.RevertOption
+		if v.config.Revert.VerifyCommit == nil || *v.config.Revert.VerifyCommit {
+			revertOpts = append(revertOpts, rule.WithRevertedCommitCheck(v.repo.CommitExists))
+		}
+
+		revertRule := rule.ValidateRevert(commitInfo.Subject, commitInfo.Body, revertOpts...)
+		report.Add(revertRule)
+	}
+
+	if v.config.IssueReference != nil && when.active(v.config.IssueReference.When) {
+		issueReferenceRule := rule.ValidateIssueReference(commitInfo.Subject, commitInfo.Body,
+			rule.WithIssueTrackers(v.issueTrackers()...))
+		report.Add(issueReferenceRule)
+
+		if refs := v.verifiableIssueRefs(issueReferenceRule.References()); len(refs) > 0 {
+			issueStatusRule := rule.ValidateIssueStatus(refs, v.issueStatusOptions()...)
+			report.Add(issueStatusRule)
+		}
+	}
+
+	if v.config.CoAuthors != nil && when.active(v.config.CoAuthors.When) {
+		coAuthorsRule := rule.ValidateCoAuthors(commitInfo.Message, v.coAuthorsOptions(commitInfo)...)
+		report.Add(coAuthorsRule)
+	}
+
+	v.checkCustomRules(report, commitInfo, when)
+	v.checkExpressionRules(report, commitInfo, when)
+}
+
+func (v *Validator) checkMergePolicy(report *model.CommitRules, commitInfo model.CommitInfo, when *whenContext) {
+	mergePolicy := v.config.MergePolicy
+	if mergePolicy == nil || !when.active(mergePolicy.When) {
+		return
+	}
+
+	var parentHashes []string
+
+	if commitInfo.IsMergeCommit {
+		for _, hash := range commitInfo.RawCommit.ParentHashes {
+			parentHashes = append(parentHashes, hash.String())
+		}
+	}
+
+	opts := []rule.MergePolicyOption{
+		rule.WithMergesForbidden(mergePolicy.Forbid),
+		rule.WithMergeSubjectTemplate(mergePolicy.SubjectTemplate),
+	}
+
+	if mergePolicy.BaseBranchOnly {
+		opts = append(opts, rule.WithMergeBaseBranch(v.mergeBaseBranch(), v.repo.IsAncestor))
+	}
+
+	mergePolicyRule := rule.ValidateMergePolicy(commitInfo.Subject, parentHashes, opts...)
+	report.Add(mergePolicyRule)
+}
+
+// mergeBaseBranch returns the branch merged commits must be part of: the base branch given
+// with --base-branch, or else the main branch of the repository. It is "" if neither is known.
+func (v *Validator) mergeBaseBranch() string {
+	if v.options.BaseBranch != "" {
+		return v.options.BaseBranch
+	}
+
+	if branch, ok := gitService.FindMainBranch(v.repo.Repo); ok {
+		return branch
+	}
+
+	return ""
+}
+
+// isSkippedRevert reports whether the commit is a revert for which the subject rules are skipped.
+func (v *Validator) isSkippedRevert(commitInfo model.CommitInfo, when *whenContext) bool {
+	revert := v.config.Revert
+
+	return revert != nil && revert.SkipSubjectRules && when.active(revert.When) && rule.IsRevertSubject(commitInfo.Subject)
+}
+
+// commitIdentityOptions converts the identity configuration into CommitIdentity options.
+func (v *Validator) commitIdentityOptions() []rule.CommitIdentityOption {
+	identity := v.config.CommitIdentity
+
+	opts := []rule.CommitIdentityOption{
+		rule.WithAllowedIdentityDomains(identity.AllowedDomains),
+		rule.WithAllowedIdentityPatterns(identity.AllowedPatterns),
+		rule.WithFullNameRequired(identity.RequireFullName),
+		rule.WithNoreplyForbidden(identity.ForbidNoreply),
+		rule.WithSameCommitterRequired(identity.RequireSameCommitter),
+	}
+
+	if mailmap := identity.ParsedMailmap(); mailmap != nil {
+		opts = append(opts, rule.WithIdentityMailmap(mailmap))
+	}
+
+	return opts
+}
+
+// coAuthorsOptions converts the co-authors configuration into CoAuthors options.
+func (v *Validator) coAuthorsOptions(commitInfo model.CommitInfo) []rule.CoAuthorsOption {
+	coAuthors := v.config.CoAuthors
+
+	opts := []rule.CoAuthorsOption{rule.WithAllowedCoAuthorDomains(coAuthors.AllowedDomains)}
+
+	if mailmap := coAuthors.ParsedMailmap(); mailmap != nil {
+		opts = append(opts, rule.WithCoAuthorMailmap(mailmap))
+	}
+
+	if author, err := v.commitAuthor(commitInfo); err == nil {
+		opts = append(opts, rule.WithCoAuthorsCommitAuthor(author.Name, author.Email))
+	}
+
+	return opts
+}
+
+// trailersConfig converts the trailers configuration into a TrailersConfig.
+func (v *Validator) trailersConfig() rule.TrailersConfig {
+	trailers := v.config.Trailers
+
+	config := rule.TrailersConfig{
+		Order:                 trailers.Order,
+		RequireFinalParagraph: trailers.FinalParagraph == nil || *trailers.FinalParagraph,
+	}
+
+	for _, key := range trailers.Keys {
+		config.Specs = append(config.Specs, rule.TrailerSpec{
+			Key:       key.Key,
+			Required:  key.Required,
+			Forbidden: key.Forbidden,
+			Pattern:   key.Pattern,
+			Min:       key.Min,
+			Max:       key.Max,
+		})
+	}
+
+	return config
+}
+
+// issueTrackers converts the issue reference configuration into tracker profiles, in
+// name order. Settings override the built-in profile of the same name; the jira profile
+// defaults its projects to the subject.jira keys.
+func (v *Validator) issueTrackers() []rule.IssueTracker {
+	configured := v.config.IssueReference.Trackers
+	names := slices.Sorted(maps.Keys(configured))
+	trackers := make([]rule.IssueTracker, 0, len(names))
+
+	for _, name := range names {
+		tracker, builtIn := rule.DefaultIssueTrackers[name]
+		if !builtIn {
+			tracker = rule.IssueTracker{Name: name}
+		}
+
+		settings := configured[name]
+		if settings.Pattern != "" {
+			tracker.Pattern = settings.Pattern
+		}
+
+		if len(settings.Placements) > 0 {
+			tracker.Placements = settings.Placements
+		}
+
+		if len(settings.ClosingKeywords) > 0 {
+			tracker.ClosingKeywords = settings.ClosingKeywords
+		}
+
+		if len(settings.Projects) > 0 {
+			tracker.Projects = settings.Projects
+		} else if name == "jira" && v.config.Subject != nil && v.config.Subject.Jira != nil {
+			tracker.Projects = v.config.Subject.Jira.Keys
+		}
+
+		trackers = append(trackers, tracker)
+	}
+
+	return trackers
+}
+
+// hasIssueTracker reports whether the issue reference configuration has the named tracker.
+func (v *Validator) hasIssueTracker(name string) bool {
+	if v.config.IssueReference == nil {
+		return false
+	}
+
+	_, ok := v.config.IssueReference.Trackers[name]
+
+	return ok
+}
+
+// checkCustomRules adds a rule for each custom rule that applies to the commit.
+func (v *Validator) checkCustomRules(report *model.CommitRules, commitInfo model.CommitInfo, when *whenContext) {
+	var author string
+
+	for index, customRule := range v.config.CustomRules {
+		if !when.active(customRule.When) {
+			continue
+		}
+
+		if author == "" && customRule.Target == rule.TargetAuthor {
+			if ident, err := v.commitAuthor(commitInfo); err == nil {
+				author = fmt.Sprintf("%s <%s>", ident.Name, ident.Email)
+			}
+		}
+
+		name := customRule.Name
+		if name == "" {
+			name = fmt.Sprintf("CustomRule%d", index+1)
+		}
+
+		customRuleResult := rule.ValidateCustomRule(rule.CustomRuleSpec{
+			Name:         name,
+			Target:       customRule.Target,
+			Trailer:      customRule.Trailer,
+			MustMatch:    customRule.MustMatch,
+			MustNotMatch: customRule.MustNotMatch,
+			Message:      customRule.Message,
+			Help:         customRule.Help,
+
+			MustMatchRegexes:    customRule.MustMatchRegexes(),
+			MustNotMatchRegexes: customRule.MustNotMatchRegexes(),
+		}, rule.CustomRuleCommit{Message: commitInfo.Message, Author: author})
+		report.Add(customRuleResult)
+	}
+}
+
+// checkExpressionRules adds a rule for each expression rule that applies to the commit.
+func (v *Validator) checkExpressionRules(report *model.CommitRules, commitInfo model.CommitInfo, when *whenContext) {
+	var commit *expression.Commit
+
+	for index, expressionRule := range v.config.ExpressionRules {
+		if !when.active(expressionRule.When) {
+			continue
+		}
+
+		if commit == nil {
+			commit = v.expressionCommit(commitInfo, when)
+		}
+
+		name := expressionRule.Name
+		if name == "" {
+			name = fmt.Sprintf("ExpressionRule%d", index+1)
+		}
+
+		expressionRuleResult := rule.ValidateExpressionRule(rule.ExpressionRuleSpec{
+			Name:       name,
+			Expression: expressionRule.Expression,
+			Program:    expressionRule.Program(),
+			Message:    expressionRule.Message,
+			Help:       expressionRule.Help,
+		}, *commit)
+		report.Add(expressionRuleResult)
+	}
+}
+
+// expressionCommit collects the commit data that expression rules see. For a commit
+// message file the changed paths and parents are unknown, and the author is the one git
+// would record.
+func (v *Validator) expressionCommit(commitInfo model.CommitInfo, when *whenContext) *expression.Commit {
+	commit := &expression.Commit{
+		Subject:  commitInfo.Subject,
+		Body:     commitInfo.Body,
+		Message:  commitInfo.Message,
+		Trailers: make(map[string][]string),
+	}
+
+	if conventional := commitInfo.Conventional; conventional != nil {
+		commit.Conventional = true
+		commit.Type = conventional.Type
+		commit.Scopes = conventional.Scopes
+		commit.Breaking = conventional.Breaking
+		commit.Description = conventional.Description
+	}
+
+	for _, trailer := range model.ParseTrailers(commitInfo.Message).Block {
+		key := expression.TrailerKey(trailer.Key)
+		commit.Trailers[key] = append(commit.Trailers[key], trailer.Value)
+	}
+
+	if author, err := v.commitAuthor(commitInfo); err == nil {
+		commit.Author = author
+	}
+
+	if paths, known := when.changedPaths(); known {
+		commit.PathsKnown = true
+		commit.ChangedPaths = paths
+	}
+
+	if commitInfo.RawCommit != nil {
+		commit.ParentCount = commitInfo.RawCommit.NumParents()
+	}
+
+	return commit
+}
+
+// bannedTermsOptions converts the banned terms configuration into BannedTerms options.
+func (v *Validator) bannedTermsOptions() []rule.BannedTermsOption {
+	bannedTerms := v.config.BannedTerms
+
+	terms := make([]rule.BannedTerm, 0, len(bannedTerms.Terms))
+	for _, term := range bannedTerms.Terms {
+		terms = append(terms, rule.BannedTerm{Term: term.Term, Suggestions: term.Suggestions})
+	}
+
+	opts := []rule.BannedTermsOption{
+		rule.WithBannedTerms(terms),
+		rule.WithAllowedContexts(bannedTerms.AllowIn),
+	}
+
+	if bannedTerms.Inclusive {
+		opts = append(opts, rule.WithBannedTerms(rule.InclusiveLanguageTerms))
+	}
+
+	return opts
+}
+
+// bodyLineLengthOptions converts the body configuration into BodyLineLength options.
+func (v *Validator) bodyLineLengthOptions() []rule.BodyLineLengthOption {
+	opts := []rule.BodyLineLengthOption{rule.WithMaxBodyLineLength(v.config.Body.MaxLineLength)}
+
+	exemptions := v.config.Body.LineLengthExemptions
+	if exemptions == nil {
+		return opts
+	}
+
+	if exemptions.URLs != nil {
+		opts = append(opts, rule.WithURLExemption(*exemptions.URLs))
+	}
+
+	if exemptions.CodeBlocks != nil {
+		opts = append(opts, rule.WithCodeBlockExemption(*exemptions.CodeBlocks))
+	}
+
+	if exemptions.Quotes != nil {
+		opts = append(opts, rule.WithQuoteExemption(*exemptions.Quotes))
+	}
+
+	if exemptions.Trailers != nil {
+		opts = append(opts, rule.WithTrailerExemption(*exemptions.Trailers))
+	}
+
+	return append(opts, rule.WithExemptPatterns(exemptions.Patterns))
 }
@@ -0,0 +1,190 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+package validation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/itiquette/gommitlint/internal/configuration"
+	"github.com/itiquette/gommitlint/internal/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMergePolicyBaseBranch(t *testing.T) {
+	repo, err := git.PlainInit(t.TempDir(), false)
+	require.NoError(t, err)
+
+	worktree, err := repo.Worktree()
+	require.NoError(t, err)
+
+	commit := func(message string, parents ...plumbing.Hash) plumbing.Hash {
+		hash, err := worktree.Commit(message, &git.CommitOptions{
+			Author:            &object.Signature{Name: "Test User", Email: "test@example.com", When: time.Now()},
+			Parents:           parents,
+			AllowEmptyCommits: true,
+		})
+		require.NoError(t, err)
+
+		return hash
+	}
+
+	root := commit("Initial commit")
+	base := commit("Base work", root)
+	other := commit("Other work", root)
+	feature := commit("Feature work", root)
+	mergeBase := commit("Merge branch 'main' into feature", feature, base)
+	mergeOther := commit("Merge branch 'other' into feature", feature, other)
+
+	// Only main is a branch; the commits were made on master
+	require.NoError(t, repo.Storer.SetReference(plumbing.NewHashReference(plumbing.NewBranchReferenceName("main"), base)))
+	require.NoError(t, repo.Storer.RemoveReference(plumbing.NewBranchReferenceName("master")))
+
+	validate := func(options *model.Options, hash plumbing.Hash) []string {
+		rawCommit, err := repo.CommitObject(hash)
+		require.NoError(t, err)
+
+		validator := &Validator{
+			repo:    &model.Repository{Repo: repo},
+			options: options,
+			config: &configuration.GommitLintConfig{
+				MergePolicy: &configuration.MergePolicyRule{BaseBranchOnly: true},
+			},
+		}
+
+		commitInfo := model.NewCommitInfo(rawCommit.Message, rawCommit)
+		report := model.NewCommitRules()
+		validator.checkMergePolicy(report, commitInfo, validator.newWhenContext(commitInfo))
+
+		var codes []string
+		for _, err := range report.All()[0].Errors() {
+			codes = append(codes, err.Code)
+		}
+
+		return codes
+	}
+
+	tests := []struct {
+		name      string
+		options   *model.Options
+		commit    plumbing.Hash
+		wantCodes []string
+	}{
+		{
+			name:    "merge of the detected main branch",
+			options: &model.Options{CommitRef: mergeBase.String()},
+			commit:  mergeBase,
+		},
+		{
+			// The merged commit is part of the git reference, but not of the base branch
+			name:      "merge of another branch with a git reference",
+			options:   &model.Options{CommitRef: mergeOther.String()},
+			commit:    mergeOther,
+			wantCodes: []string{"merge_source_not_allowed"},
+		},
+		{
+			name:    "merge of the given base branch",
+			options: &model.Options{BaseBranch: other.String()},
+			commit:  mergeOther,
+		},
+		{
+			name:      "merge of the main branch with another base branch",
+			options:   &model.Options{BaseBranch: other.String()},
+			commit:    mergeBase,
+			wantCodes: []string{"merge_source_not_allowed"},
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			require.Equal(t, tabletest.wantCodes, validate(tabletest.options, tabletest.commit))
+		})
+	}
+
+	// Without --base-branch and a main branch the merged commits cannot be checked
+	require.NoError(t, repo.Storer.RemoveReference(plumbing.NewBranchReferenceName("main")))
+	require.Equal(t, []string{"base_branch_unknown"}, validate(&model.Options{CommitRef: mergeBase.String()}, mergeBase))
+}
+
+func TestJiraKeysCheckedOnce(t *testing.T) {
+	commitInfo := model.NewCommitInfo("feat: add login", nil)
+
+	tests := []struct {
+		name           string
+		issueReference *configuration.IssueReferenceRule
+		wantRules      []string
+	}{
+		{
+			name:      "subject jira only",
+			wantRules: []string{"JiraReference"},
+		},
+		{
+			name: "jira tracker replaces subject jira",
+			issueReference: &configuration.IssueReferenceRule{
+				Trackers: map[string]configuration.IssueTrackerRule{"jira": {}},
+			},
+			wantRules: []string{"IssueReference"},
+		},
+		{
+			name: "other tracker keeps subject jira",
+			issueReference: &configuration.IssueReferenceRule{
+				Trackers: map[string]configuration.IssueTrackerRule{"github": {}},
+			},
+			wantRules: []string{"JiraReference", "IssueReference"},
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			validator := &Validator{
+				options: &model.Options{},
+				config: &configuration.GommitLintConfig{
+					Subject: &configuration.SubjectRule{
+						Jira: &configuration.JiraRule{Required: true, Keys: []string{"PROJ"}},
+					},
+					IssueReference: tabletest.issueReference,
+					NCommitsAhead:  boolPtr(false),
+				},
+			}
+			validator.ensureDefaultValues()
+
+			report := model.NewCommitRules()
+			when := validator.newWhenContext(commitInfo)
+			validator.checkSubjectRules(report, commitInfo, when)
+			validator.checkAdditionalRules(report, commitInfo, when)
+
+			var failed []string
+
+			for _, commitRule := range report.All() {
+				if commitRule.Name() == "JiraReference" || commitRule.Name() == "IssueReference" {
+					require.NotEmpty(t, commitRule.Errors())
+
+					failed = append(failed, commitRule.Name())
+				}
+			}
+
+			require.Equal(t, tabletest.wantRules, failed)
+		})
+	}
+}
+
+func TestExpressionCommitMessageFile(t *testing.T) {
+	commitInfo := model.NewCommitInfo("Fix cache\n\nSigned-Off-By: Jane Doe <jane@example.com>\nsigned-off-by: John Smith <john@example.com>", nil)
+
+	validator := &Validator{options: &model.Options{}, config: &configuration.GommitLintConfig{}}
+	commit := validator.expressionCommit(commitInfo, validator.newWhenContext(commitInfo))
+
+	// Trailer keys are case-insensitive
+	require.Equal(t, map[string][]string{
+		"Signed-off-by": {"Jane Doe <jane@example.com>", "John Smith <john@example.com>"},
+	}, commit.Trailers)
+
+	// A commit message file has no changed paths or parents to report
+	require.False(t, commit.PathsKnown)
+	require.Empty(t, commit.ChangedPaths)
+	require.Zero(t, commit.ParentCount)
+}
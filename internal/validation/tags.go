@@ -0,0 +1,62 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+package validation
+
+import (
+	"fmt"
+
+	"github.com/itiquette/gommitlint/internal/model"
+	"github.com/itiquette/gommitlint/internal/rule"
+	"github.com/itiquette/gommitlint/internal/rule/signedidentityrule"
+)
+
+// GetTagsToValidate retrieves all tags matching the tag pattern option.
+func (v *Validator) GetTagsToValidate() ([]model.TagInfo, error) {
+	tags, err := v.repo.TagInfos(v.options.TagPattern)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+
+	return tags, nil
+}
+
+// ValidateTag validates a single tag and returns its rules.
+func (v *Validator) ValidateTag(tagInfo model.TagInfo) (*model.CommitRules, error) {
+	tagRules := model.NewCommitRules()
+
+	v.ensureDefaultValues()
+	v.checkTagRules(tagRules, tagInfo)
+
+	return tagRules, nil
+}
+
+func (v *Validator) checkTagRules(report *model.CommitRules, tagInfo model.TagInfo) {
+	tag := v.config.Tag
+
+	tagNameRule := rule.ValidateTagName(tagInfo.Name, tag.NamePattern)
+	report.Add(tagNameRule)
+
+	if *tag.Annotated {
+		annotatedTagRule := rule.ValidateAnnotatedTag(tagInfo.Name, tagInfo.IsAnnotated)
+		report.Add(annotatedTagRule)
+	}
+
+	// Lightweight tags have no message, which AnnotatedTag already reports
+	if *tag.MessageRequired && tagInfo.IsAnnotated {
+		tagMessageRule := rule.ValidateTagMessage(tagInfo.Name, tagInfo.Message)
+		report.Add(tagMessageRule)
+	}
+
+	if v.config.Signature.Required {
+		signatureRule := rule.ValidateSignature(tagInfo.Signature)
+		report.Add(signatureRule)
+
+		if v.config.Signature.Identity != nil && tagInfo.IsAnnotated {
+			identity := v.config.Signature.Identity
+
+			signedIdentityRule := signedidentityrule.VerifyTagSignatureIdentity(tagInfo.RawTag, tagInfo.Signature, identity.PublicKeyURI, v.signedIdentityOptions()...)
+			report.Add(signedIdentityRule)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	"github.com/itiquette/gommitlint/internal/configuration"
+	"github.com/itiquette/gommitlint/internal/issuetracker"
 	"github.com/itiquette/gommitlint/internal/model"
 )
 
@@ -15,6 +16,12 @@ type Validator struct {
 	repo    *model.Repository
 	options *model.Options
 	config  *configuration.GommitLintConfig
+
+	// Issue tracker API clients by tracker name, sharing one cache, and the errors of
+	// clients that could not be created
+	issueClients      map[string]*issuetracker.Client
+	issueClientErrors map[string]error
+	issueCache        *issuetracker.Cache
 }
 
 // NewValidator creates a new Validator instance.
@@ -0,0 +1,131 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+package validation
+
+import (
+	"path"
+	"strings"
+
+	"github.com/itiquette/gommitlint/internal/configuration"
+	gitService "github.com/itiquette/gommitlint/internal/git"
+	"github.com/itiquette/gommitlint/internal/model"
+)
+
+// whenContext evaluates when: conditions for a single commit.
+// The changed paths of the commit are listed on first use, since most configurations never
+// need them, and shared by the rules that do.
+type whenContext struct {
+	branch      string
+	commitInfo  model.CommitInfo
+	paths       []string
+	pathsLoaded bool
+	pathsKnown  bool
+}
+
+func (v *Validator) newWhenContext(commitInfo model.CommitInfo) *whenContext {
+	return &whenContext{
+		branch:     strings.TrimPrefix(v.options.TargetBranch, "refs/heads/"),
+		commitInfo: commitInfo,
+	}
+}
+
+// active reports whether the rules guarded by when apply to the commit.
+// A nil condition always applies. Conditions that cannot be evaluated, such as the
+// changed paths of a commit message file, are treated as matching so that rules are
+// never skipped because information is missing.
+func (c *whenContext) active(when *configuration.WhenRule) bool {
+	if when == nil {
+		return true
+	}
+
+	return c.branchMatches(when.Branches) && c.pathsMatch(when.Paths) && c.authorMatches(when.Authors)
+}
+
+func (c *whenContext) branchMatches(patterns []string) bool {
+	if len(patterns) == 0 || c.branch == "" {
+		return true
+	}
+
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(strings.TrimPrefix(pattern, "refs/heads/"), c.branch); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c *whenContext) pathsMatch(patterns []string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+
+	paths, known := c.changedPaths()
+	if !known {
+		return true
+	}
+
+	for _, changedPath := range paths {
+		for _, pattern := range patterns {
+			if gitService.MatchPath(pattern, changedPath) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// changedPaths returns the paths the commit changes compared to its first parent.
+// The second result is false if the paths are unknown, e.g. for a commit message file.
+func (c *whenContext) changedPaths() ([]string, bool) {
+	if !c.pathsLoaded {
+		c.pathsLoaded = true
+
+		if c.commitInfo.RawCommit != nil {
+			paths, err := gitService.CommitPaths(c.commitInfo.RawCommit)
+			if err == nil {
+				c.paths = paths
+				c.pathsKnown = true
+			}
+		}
+	}
+
+	return c.paths, c.pathsKnown
+}
+
+// fileChanges returns the files the commit changes compared to its first parent, with the
+// details selected by options. The second result is false if the changes are unknown.
+func (c *whenContext) fileChanges(options gitService.ChangeOptions) ([]gitService.FileChange, bool) {
+	if c.commitInfo.RawCommit == nil {
+		return nil, false
+	}
+
+	changes, err := gitService.CommitChanges(c.commitInfo.RawCommit, options)
+	if err != nil {
+		return nil, false
+	}
+
+	return changes, true
+}
+
+func (c *whenContext) authorMatches(patterns []string) bool {
+	if len(patterns) == 0 || c.commitInfo.RawCommit == nil {
+		return true
+	}
+
+	author := c.commitInfo.RawCommit.Author
+
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(strings.ToLower(pattern), strings.ToLower(author.Email)); matched {
+			return true
+		}
+
+		if matched, _ := path.Match(pattern, author.Name); matched {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,191 @@
+// SPDX-FileCopyrightText: 2025 itiquette/gommitlint <https://github.com/itiquette/gommitlint>
+//
+// SPDX-License-Identifier: EUPL-1.2
+package validation
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/itiquette/gommitlint/internal/configuration"
+	gitService "github.com/itiquette/gommitlint/internal/git"
+	"github.com/itiquette/gommitlint/internal/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchChangedPath(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		path     string
+		expected bool
+	}{
+		{pattern: "deploy/", path: "deploy/app.yaml", expected: true},
+		{pattern: "deploy/", path: "deploy/prod/app.yaml", expected: true},
+		{pattern: "/deploy/", path: "deploy/app.yaml", expected: true},
+		{pattern: "deploy/**", path: "deploy/prod/app.yaml", expected: true},
+		{pattern: "deploy/", path: "src/deploy/app.yaml", expected: false},
+		{pattern: "deploy/", path: "deployment.md", expected: false},
+		{pattern: "services/*/api/**", path: "services/billing/api/v1/invoice.proto", expected: true},
+		{pattern: "services/*/api/**", path: "services/billing/internal/api.go", expected: false},
+		{pattern: ".github/workflows/*.yml", path: ".github/workflows/ci.yml", expected: true},
+		{pattern: ".github/workflows/*.yml", path: ".github/workflows/nested/ci.yml", expected: false},
+		{pattern: "*.tf", path: "infra/main.tf", expected: true},
+		{pattern: "*.tf", path: "infra/main.tfvars", expected: false},
+		{pattern: "go.mod", path: "go.mod", expected: true},
+		{pattern: "go.mod", path: "tools/go.mod", expected: true},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.pattern+" "+tabletest.path, func(t *testing.T) {
+			assert.Equal(t, tabletest.expected, gitService.MatchPath(tabletest.pattern, tabletest.path))
+		})
+	}
+}
+
+func TestWhenContextActive(t *testing.T) {
+	dir := t.TempDir()
+
+	repo, err := git.PlainInit(dir, false)
+	require.NoError(t, err)
+
+	worktree, err := repo.Worktree()
+	require.NoError(t, err)
+
+	require.NoError(t, os.MkdirAll(filepath.Join(dir, "deploy"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "deploy", "app.yaml"), []byte("replicas: 2\n"), 0600))
+
+	_, err = worktree.Add("deploy/app.yaml")
+	require.NoError(t, err)
+
+	hash, err := worktree.Commit("Deploy two replicas", &git.CommitOptions{
+		Author: &object.Signature{Name: "Release Bot", Email: "Bot@Example.com", When: time.Now()},
+	})
+	require.NoError(t, err)
+
+	commit, err := repo.CommitObject(hash)
+	require.NoError(t, err)
+
+	commitInfo := model.CommitInfo{RawCommit: commit}
+	fileInfo := model.CommitInfo{Message: "Deploy two replicas"}
+
+	tests := []struct {
+		name       string
+		branch     string
+		commitInfo model.CommitInfo
+		when       *configuration.WhenRule
+		expected   bool
+	}{
+		{name: "no condition", commitInfo: commitInfo, when: nil, expected: true},
+		{name: "empty condition", commitInfo: commitInfo, when: &configuration.WhenRule{}, expected: true},
+		{
+			name: "branch matches", branch: "release/1.0", commitInfo: commitInfo,
+			when: &configuration.WhenRule{Branches: []string{"main", "release/*"}}, expected: true,
+		},
+		{
+			name: "full branch reference", branch: "refs/heads/release/1.0", commitInfo: commitInfo,
+			when: &configuration.WhenRule{Branches: []string{"refs/heads/release/*"}}, expected: true,
+		},
+		{
+			name: "branch does not match", branch: "feature/x", commitInfo: commitInfo,
+			when: &configuration.WhenRule{Branches: []string{"release/*"}}, expected: false,
+		},
+		{
+			name: "unknown branch", branch: "", commitInfo: commitInfo,
+			when: &configuration.WhenRule{Branches: []string{"release/*"}}, expected: true,
+		},
+		{
+			name: "path matches", commitInfo: commitInfo,
+			when: &configuration.WhenRule{Paths: []string{".github/workflows/", "deploy/"}}, expected: true,
+		},
+		{
+			name: "path does not match", commitInfo: commitInfo,
+			when: &configuration.WhenRule{Paths: []string{".github/workflows/"}}, expected: false,
+		},
+		{
+			name: "paths unknown for message file", commitInfo: fileInfo,
+			when: &configuration.WhenRule{Paths: []string{".github/workflows/"}}, expected: true,
+		},
+		{
+			name: "author email matches case insensitively", commitInfo: commitInfo,
+			when: &configuration.WhenRule{Authors: []string{"*@example.com"}}, expected: true,
+		},
+		{
+			name: "author name matches", commitInfo: commitInfo,
+			when: &configuration.WhenRule{Authors: []string{"Release *"}}, expected: true,
+		},
+		{
+			name: "author does not match", commitInfo: commitInfo,
+			when: &configuration.WhenRule{Authors: []string{"*@other.org"}}, expected: false,
+		},
+		{
+			name: "all conditions must match", branch: "release/1.0", commitInfo: commitInfo,
+			when: &configuration.WhenRule{
+				Branches: []string{"release/*"},
+				Paths:    []string{"deploy/"},
+				Authors:  []string{"*@other.org"},
+			},
+			expected: false,
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			validator := &Validator{options: &model.Options{TargetBranch: tabletest.branch}}
+
+			when := validator.newWhenContext(tabletest.commitInfo)
+			assert.Equal(t, tabletest.expected, when.active(tabletest.when))
+		})
+	}
+}
+
+func TestSignOffWhen(t *testing.T) {
+	commitInfo := model.NewCommitInfo("Add login\n\nWithout a sign-off.", nil)
+
+	tests := []struct {
+		name         string
+		branch       string
+		identity     *configuration.SignOffIdentityRule
+		wantSignOffs int
+	}{
+		{name: "no condition", branch: "feature/login", wantSignOffs: 1},
+		{
+			name: "condition matches", branch: "main",
+			identity: &configuration.SignOffIdentityRule{When: &configuration.WhenRule{Branches: []string{"main"}}}, wantSignOffs: 1,
+		},
+		{
+			name: "condition does not match", branch: "feature/login",
+			identity: &configuration.SignOffIdentityRule{When: &configuration.WhenRule{Branches: []string{"main"}}}, wantSignOffs: 0,
+		},
+	}
+
+	for _, tabletest := range tests {
+		t.Run(tabletest.name, func(t *testing.T) {
+			validator := &Validator{
+				options: &model.Options{TargetBranch: tabletest.branch},
+				config: &configuration.GommitLintConfig{
+					SignOffRequired: boolPtr(true),
+					SignOffIdentity: tabletest.identity,
+					Signature:       &configuration.SignatureRule{Required: false},
+				},
+			}
+
+			report := model.NewCommitRules()
+			validator.checkSignatureRules(report, commitInfo, validator.newWhenContext(commitInfo))
+
+			signOffs := 0
+
+			for _, commitRule := range report.All() {
+				if commitRule.Name() == "SignOff" {
+					signOffs++
+				}
+			}
+
+			assert.Equal(t, tabletest.wantSignOffs, signOffs)
+		})
+	}
+}